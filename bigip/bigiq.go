@@ -0,0 +1,142 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// bigiqLicenseSchema returns the arguments shared by the BIG-IQ license
+// resources: the BIG-IQ to license from and the BIG-IP to license.
+func bigiqLicenseSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	common := map[string]*schema.Schema{
+		"bigiq_address": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Domain name/IP of the BIG-IQ managing the licenses",
+		},
+		"bigiq_user": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Username with API access to the BIG-IQ",
+		},
+		"bigiq_password": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Sensitive:   true,
+			Description: "Password of the BIG-IQ user",
+		},
+		"bigiq_token_auth": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+			Description: "Enable to use an external authentication source on the BIG-IQ (LDAP, TACACS, etc)",
+		},
+		"bigiq_login_ref": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     "local",
+			Description: "Login reference for token authentication on the BIG-IQ",
+		},
+		"device_address": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Management address of the BIG-IP to license, defaults to the provider address",
+		},
+		"device_username": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Username BIG-IQ uses to install the license on the BIG-IP, defaults to the provider username",
+		},
+		"device_password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Sensitive:   true,
+			Description: "Password BIG-IQ uses to install the license on the BIG-IP, defaults to the provider password",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Status of the license assignment reported by the BIG-IQ",
+		},
+	}
+	for k, v := range s {
+		common[k] = v
+	}
+	return common
+}
+
+// bigiqClient connects to the BIG-IQ configured on the resource.
+func bigiqClient(d *schema.ResourceData) (*bigip.BigIP, error) {
+	config := Config{
+		Address:  d.Get("bigiq_address").(string),
+		Username: d.Get("bigiq_user").(string),
+		Password: d.Get("bigiq_password").(string),
+	}
+	if d.Get("bigiq_token_auth").(bool) {
+		config.LoginReference = d.Get("bigiq_login_ref").(string)
+	}
+	return config.Client()
+}
+
+// bigiqLicenseMember builds the license assignment for the target BIG-IP. The
+// provider connection is used for the device arguments that are not set.
+func bigiqLicenseMember(d *schema.ResourceData, meta interface{}) *bigip.LicensePoolMember {
+	client := meta.(*bigip.BigIP)
+
+	m := &bigip.LicensePoolMember{
+		DeviceAddress: d.Get("device_address").(string),
+		Username:      d.Get("device_username").(string),
+		Password:      d.Get("device_password").(string),
+	}
+	if m.DeviceAddress == "" {
+		m.DeviceAddress = strings.TrimPrefix(client.Host, "https://")
+		d.Set("device_address", m.DeviceAddress)
+	}
+	if m.Username == "" {
+		m.Username = client.User
+		d.Set("device_username", m.Username)
+	}
+	if m.Password == "" {
+		m.Password = client.Password
+		d.Set("device_password", m.Password)
+	}
+	return m
+}
+
+// waitForBigiqLicense polls the license assignment until BIG-IQ reports the
+// license as installed on the BIG-IP.
+func waitForBigiqLicense(d *schema.ResourceData, get func() (*bigip.LicensePoolMember, error)) error {
+	return resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		m, err := get()
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if m == nil {
+			return resource.NonRetryableError(fmt.Errorf("license assignment %s disappeared from the BIG-IQ", d.Id()))
+		}
+		log.Printf("[DEBUG] License assignment (%s) status %s", d.Id(), m.Status)
+		switch m.Status {
+		case "LICENSED":
+			return nil
+		case "FAILED", "REVOKE_FAILED":
+			return resource.NonRetryableError(fmt.Errorf("licensing %s failed: %s", m.DeviceAddress, m.ErrorText))
+		}
+		return resource.RetryableError(fmt.Errorf("license assignment %s is %s", d.Id(), m.Status))
+	})
+}
@@ -49,13 +49,41 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"bigip_as3":                             resourceBigipAs3(),
+			"bigip_auth_ldap":                       resourceBigipAuthLdap(),
+			"bigip_auth_radius":                     resourceBigipAuthRadius(),
+			"bigip_auth_radius_server":              resourceBigipAuthRadiusServer(),
+			"bigip_auth_remote_role":                resourceBigipAuthRemoteRole(),
+			"bigip_auth_source":                     resourceBigipAuthSource(),
+			"bigip_auth_tacacs":                     resourceBigipAuthTacacs(),
+			"bigip_auth_user":                       resourceBigipAuthUser(),
+			"bigip_bigiq_regkey_license":            resourceBigipBigiqRegkeyLicense(),
+			"bigip_bigiq_utility_license":           resourceBigipBigiqUtilityLicense(),
+			"bigip_cm_config_sync":                  resourceBigipCmConfigSync(),
 			"bigip_cm_device":                       resourceBigipCmDevice(),
+			"bigip_cm_device_failover":              resourceBigipCmDeviceFailover(),
 			"bigip_cm_devicegroup":                  resourceBigipCmDevicegroup(),
+			"bigip_cm_traffic_group":                resourceBigipCmTrafficGroup(),
+			"bigip_cm_trust":                        resourceBigipCmTrust(),
+			"bigip_do":                              resourceBigipDo(),
+			"bigip_gtm_datacenter":                  resourceBigipGtmDatacenter(),
+			"bigip_gtm_monitor":                     resourceBigipGtmMonitor(),
+			"bigip_gtm_pool":                        resourceBigipGtmPool(),
+			"bigip_gtm_server":                      resourceBigipGtmServer(),
+			"bigip_gtm_wideip":                      resourceBigipGtmWideip(),
+			"bigip_net_address_list":                resourceBigipNetAddressList(),
+			"bigip_net_firewall_policy":             resourceBigipNetFirewallPolicy(),
+			"bigip_net_firewall_policy_attachment":  resourceBigipNetFirewallPolicyAttachment(),
+			"bigip_net_port_list":                   resourceBigipNetPortList(),
 			"bigip_net_route":                       resourceBigipNetRoute(),
 			"bigip_net_selfip":                      resourceBigipNetSelfIP(),
 			"bigip_net_vlan":                        resourceBigipNetVlan(),
+			"bigip_security_bot_defense_profile":    resourceBigipSecurityBotDefenseProfile(),
+			"bigip_security_dos_profile":            resourceBigipSecurityDosProfile(),
+			"bigip_ltm_ifile":                       resourceBigipLtmIfile(),
 			"bigip_ltm_irule":                       resourceBigipLtmIRule(),
 			"bigip_ltm_datagroup":                   resourceBigipLtmDataGroup(),
+			"bigip_ltm_datagroup_external":          resourceBigipLtmDatagroupExternal(),
 			"bigip_ltm_monitor":                     resourceBigipLtmMonitor(),
 			"bigip_ltm_node":                        resourceBigipLtmNode(),
 			"bigip_ltm_pool":                        resourceBigipLtmPool(),
@@ -75,12 +103,27 @@ func Provider() terraform.ResourceProvider {
 			"bigip_ltm_snatpool":                    resourceBigipLtmSnatpool(),
 			"bigip_ltm_virtual_address":             resourceBigipLtmVirtualAddress(),
 			"bigip_ltm_virtual_server":              resourceBigipLtmVirtualServer(),
+			"bigip_ssl_certificate":                 resourceBigipSslCertificate(),
+			"bigip_ssl_key":                         resourceBigipSslKey(),
+			"bigip_sys_application_template":        resourceBigipSysApplicationTemplate(),
+			"bigip_sys_db":                          resourceBigipSysDb(),
 			"bigip_sys_dns":                         resourceBigipSysDns(),
+			"bigip_sys_file_datagroup":              resourceBigipSysFileDatagroup(),
+			"bigip_sys_file_ifile":                  resourceBigipSysFileIfile(),
+			"bigip_sys_global_settings":             resourceBigipSysGlobalSettings(),
 			"bigip_sys_iapp":                        resourceBigipSysIapp(),
+			"bigip_sys_management_ip":               resourceBigipSysManagementIp(),
+			"bigip_sys_management_route":            resourceBigipSysManagementRoute(),
 			"bigip_sys_ntp":                         resourceBigipSysNtp(),
+			"bigip_sys_partition":                   resourceBigipSysPartition(),
 			"bigip_sys_provision":                   resourceBigipSysProvision(),
 			"bigip_sys_snmp":                        resourceBigipSysSnmp(),
 			"bigip_sys_snmp_traps":                  resourceBigipSysSnmpTraps(),
+			"bigip_sys_software":                    resourceBigipSysSoftware(),
+			"bigip_sys_syslog":                      resourceBigipSysSyslog(),
+			"bigip_sys_ucs":                         resourceBigipSysUcs(),
+			"bigip_telemetry_streaming":             resourceBigipTelemetryStreaming(),
+			"bigip_waf_policy":                      resourceBigipWafPolicy(),
 			"bigip_sys_bigiplicense":                resourceBigipSysBigiplicense(),
 		},
 
@@ -167,3 +210,12 @@ func parseF5Identifier(str string) (partition, name string) {
 	}
 	return "", str
 }
+
+//Name of the file an object in the format /Partition/name is uploaded as, e.g. Common_name
+func uploadFileName(str string) string {
+	partition, name := parseF5Identifier(str)
+	if partition == "" {
+		return name
+	}
+	return partition + "_" + name
+}
@@ -0,0 +1,316 @@
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipAs3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipAs3Create,
+		Read:   resourceBigipAs3Read,
+		Update: resourceBigipAs3Update,
+		Delete: resourceBigipAs3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"as3_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "AS3 declaration in JSON, either an ADC declaration or an AS3 request wrapping one",
+				ValidateFunc:     validateAs3Json,
+				DiffSuppressFunc: suppressEquivalentJson,
+			},
+
+			"tenants": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tenants of the declaration, only these tenants are read and deleted",
+			},
+		},
+	}
+}
+
+func resourceBigipAs3Create(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	declaration := d.Get("as3_json").(string)
+	tenants := as3Tenants(declaration)
+	log.Println("[INFO] Creating AS3 declaration for tenants " + strings.Join(tenants, ","))
+
+	err := postAs3Declaration(client, declaration, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create AS3 declaration (%s) (%v) ", strings.Join(tenants, ","), err)
+		return err
+	}
+	d.SetId(strings.Join(tenants, ","))
+	d.Set("tenants", tenants)
+
+	return resourceBigipAs3Read(d, meta)
+}
+
+func resourceBigipAs3Read(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	tenants := strings.Split(d.Id(), ",")
+	log.Println("[INFO] Reading AS3 declaration for tenants " + d.Id())
+
+	remote, err := client.GetAs3Declaration(tenants)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve AS3 declaration (%s) (%v) ", d.Id(), err)
+		return err
+	}
+	if remote == nil {
+		log.Printf("[WARN] AS3 declaration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	declaration, err := as3Drift(d.Get("as3_json").(string), tenants, remote)
+	if err != nil {
+		return fmt.Errorf("[DEBUG] Error comparing AS3 declaration (%s): %s", d.Id(), err)
+	}
+	d.Set("as3_json", declaration)
+	d.Set("tenants", tenants)
+
+	return nil
+}
+
+func resourceBigipAs3Update(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	declaration := d.Get("as3_json").(string)
+	tenants := as3Tenants(declaration)
+	log.Println("[INFO] Updating AS3 declaration for tenants " + strings.Join(tenants, ","))
+
+	err := postAs3Declaration(client, declaration, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update AS3 declaration (%s) (%v) ", strings.Join(tenants, ","), err)
+		return err
+	}
+
+	// Tenants missing from a declaration are left alone by AS3, remove the
+	// ones this resource no longer declares.
+	var removed []string
+	for _, t := range strings.Split(d.Id(), ",") {
+		if !stringInSlice(t, tenants) {
+			removed = append(removed, t)
+		}
+	}
+	if len(removed) > 0 {
+		err = deleteAs3Tenants(client, removed, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			log.Printf("[ERROR] Unable to Delete AS3 tenants (%s) (%v) ", strings.Join(removed, ","), err)
+			return err
+		}
+	}
+	d.SetId(strings.Join(tenants, ","))
+
+	return resourceBigipAs3Read(d, meta)
+}
+
+func resourceBigipAs3Delete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Deleting AS3 tenants " + d.Id())
+
+	err := deleteAs3Tenants(client, strings.Split(d.Id(), ","), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete AS3 tenants (%s) (%v) ", d.Id(), err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func postAs3Declaration(client *bigip.BigIP, declaration string, timeout time.Duration) error {
+	task, err := client.PostAs3Declaration(json.RawMessage(declaration))
+	if err != nil {
+		return err
+	}
+	return waitForAs3Task(client, task.ID, timeout)
+}
+
+func deleteAs3Tenants(client *bigip.BigIP, tenants []string, timeout time.Duration) error {
+	task, err := client.DeleteAs3Tenants(tenants)
+	if err != nil {
+		return err
+	}
+	return waitForAs3Task(client, task.ID, timeout)
+}
+
+// waitForAs3Task polls the AS3 task until every tenant is processed and
+// returns the errors of the tenants that failed.
+func waitForAs3Task(client *bigip.BigIP, id string, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		task, err := client.GetAs3Task(id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if task.InProgress() {
+			return resource.RetryableError(fmt.Errorf("AS3 task %s in progress", id))
+		}
+		var failures []string
+		for _, r := range task.Failures() {
+			failures = append(failures, fmt.Sprintf("tenant %s: %s %s", r.Tenant, r.Message, strings.Join(r.Errors, ", ")))
+		}
+		if len(failures) > 0 {
+			return resource.NonRetryableError(fmt.Errorf("AS3 declaration failed:\n%s", strings.Join(failures, "\n")))
+		}
+		return nil
+	})
+}
+
+// as3Adc returns the ADC declaration, unwrapping an AS3 request.
+func as3Adc(declaration map[string]interface{}) map[string]interface{} {
+	if declaration["class"] == "AS3" {
+		if adc, ok := declaration["declaration"].(map[string]interface{}); ok {
+			return adc
+		}
+	}
+	return declaration
+}
+
+// as3Tenants returns the sorted names of the tenants in the declaration.
+func as3Tenants(s string) []string {
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &declaration); err != nil {
+		return nil
+	}
+	var tenants []string
+	for k, v := range as3Adc(declaration) {
+		if o, ok := v.(map[string]interface{}); ok && o["class"] == "Tenant" {
+			tenants = append(tenants, k)
+		}
+	}
+	sort.Strings(tenants)
+	return tenants
+}
+
+// as3Drift returns the declaration as it is deployed on the BIG-IP. Only the
+// properties present in the configured declaration are compared, so defaults
+// added by AS3 don't show up as changes. Without a configured declaration
+// (e.g. on import) the deployed tenants are returned.
+func as3Drift(configured string, tenants []string, remote map[string]interface{}) (string, error) {
+	remoteAdc := as3Adc(remote)
+
+	if configured == "" {
+		declaration := map[string]interface{}{
+			"class":         "ADC",
+			"schemaVersion": remoteAdc["schemaVersion"],
+		}
+		for _, t := range tenants {
+			if v, ok := remoteAdc[t]; ok {
+				declaration[t] = v
+			}
+		}
+		b, err := json.Marshal(declaration)
+		return string(b), err
+	}
+
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(configured), &declaration); err != nil {
+		return "", err
+	}
+	adc := as3Adc(declaration)
+
+	changed := false
+	for _, t := range tenants {
+		v, ok := remoteAdc[t]
+		if !ok {
+			delete(adc, t)
+			changed = true
+			continue
+		}
+		deployed := as3Filter(v, adc[t])
+		if !reflect.DeepEqual(deployed, adc[t]) {
+			adc[t] = deployed
+			changed = true
+		}
+	}
+	if !changed {
+		return configured, nil
+	}
+	b, err := json.Marshal(declaration)
+	return string(b), err
+}
+
+// as3Filter restricts the deployed value to the properties of the configured value.
+func as3Filter(deployed, configured interface{}) interface{} {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		d, ok := deployed.(map[string]interface{})
+		if !ok {
+			return deployed
+		}
+		filtered := make(map[string]interface{})
+		for k, v := range c {
+			if dv, ok := d[k]; ok {
+				filtered[k] = as3Filter(dv, v)
+			}
+		}
+		return filtered
+	case []interface{}:
+		d, ok := deployed.([]interface{})
+		if !ok || len(d) != len(c) {
+			return deployed
+		}
+		filtered := make([]interface{}, len(d))
+		for i := range d {
+			filtered[i] = as3Filter(d[i], c[i])
+		}
+		return filtered
+	}
+	return deployed
+}
+
+func validateAs3Json(value interface{}, field string) (ws []string, errors []error) {
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(value.(string)), &declaration); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", field, err))
+		return
+	}
+	if len(as3Tenants(value.(string))) == 0 {
+		errors = append(errors, fmt.Errorf("%q must declare at least one Tenant", field))
+	}
+	return
+}
+
+// suppressEquivalentJson ignores differences in formatting and key order
+func suppressEquivalentJson(k, old, new string, d *schema.ResourceData) bool {
+	var o, n interface{}
+	if err := json.Unmarshal([]byte(old), &o); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &n); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(o, n)
+}
+
+func stringInSlice(s string, list []string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package bigip
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_AS3_TENANT = "test_as3_tenant"
+
+var TEST_AS3_RESOURCE = `
+resource "bigip_as3" "test-as3" {
+	as3_json = <<EOF
+{
+	"class": "AS3",
+	"action": "deploy",
+	"declaration": {
+		"class": "ADC",
+		"schemaVersion": "3.0.0",
+		"` + TEST_AS3_TENANT + `": {
+			"class": "Tenant",
+			"app": {
+				"class": "Application",
+				"template": "http",
+				"serviceMain": {
+					"class": "Service_HTTP",
+					"virtualAddresses": ["10.0.1.10"],
+					"pool": "web_pool"
+				},
+				"web_pool": {
+					"class": "Pool",
+					"members": [{
+						"servicePort": 80,
+						"serverAddresses": ["192.0.1.10", "192.0.1.11"]
+					}]
+				}
+			}
+		}
+	}
+}
+EOF
+}
+`
+
+func TestAccBigipAs3_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAs3TenantsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_AS3_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAs3TenantExists(TEST_AS3_TENANT, true),
+					resource.TestCheckResourceAttr("bigip_as3.test-as3", "tenants.#", "1"),
+					resource.TestCheckResourceAttr("bigip_as3.test-as3", "tenants.0", TEST_AS3_TENANT),
+				),
+			},
+		},
+	})
+}
+
+func TestAs3Drift(t *testing.T) {
+	configured := `{"class":"ADC","schemaVersion":"3.0.0","t1":{"class":"Tenant","app":{"class":"Application","pool":{"class":"Pool","members":[{"servicePort":80}]}}}}`
+	pool := map[string]interface{}{
+		"class":   "Pool",
+		"members": []interface{}{map[string]interface{}{"servicePort": float64(80), "enable": true}},
+	}
+	remote := map[string]interface{}{
+		"class": "ADC",
+		"id":    "1234",
+		"t1": map[string]interface{}{
+			"class": "Tenant",
+			"app": map[string]interface{}{
+				"class":    "Application",
+				"template": "generic",
+				"pool":     pool,
+			},
+		},
+	}
+
+	declaration, err := as3Drift(configured, []string{"t1"}, remote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if declaration != configured {
+		t.Errorf("defaults added by AS3 should be ignored, got %s", declaration)
+	}
+
+	pool["members"] = []interface{}{map[string]interface{}{"servicePort": float64(8080)}}
+	declaration, err = as3Drift(configured, []string{"t1"}, remote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if suppressEquivalentJson("", configured, declaration, nil) {
+		t.Errorf("changed service port should be detected, got %s", declaration)
+	}
+}
+
+func TestAs3Tenants(t *testing.T) {
+	tenants := as3Tenants(`{"class":"AS3","declaration":{"class":"ADC","b":{"class":"Tenant"},"a":{"class":"Tenant"},"controls":{"class":"Controls"}}}`)
+	if !reflect.DeepEqual(tenants, []string{"a", "b"}) {
+		t.Errorf("expected tenants [a b], got %v", tenants)
+	}
+}
+
+func testCheckAs3TenantExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		declaration, err := client.GetAs3Declaration([]string{name})
+		if err != nil {
+			return err
+		}
+		if exists && declaration == nil {
+			return fmt.Errorf("as3 tenant %s was not created.", name)
+		}
+		if !exists && declaration != nil {
+			return fmt.Errorf("as3 tenant %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckAs3TenantsDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_as3" {
+			continue
+		}
+		if err := testCheckAs3TenantExists(rs.Primary.ID, false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
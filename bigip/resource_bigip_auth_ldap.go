@@ -0,0 +1,198 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The BIG-IP only uses the remote authentication configuration named system-auth
+const authSystemAuth = "system-auth"
+
+func resourceBigipAuthLdap() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipAuthLdapCreate,
+		Read:   resourceBigipAuthLdapRead,
+		Update: resourceBigipAuthLdapUpdate,
+		Delete: resourceBigipAuthLdapDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"servers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Addresses of the LDAP servers in order",
+			},
+
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     389,
+				Description: "Port of the LDAP servers",
+			},
+
+			"bind_dn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Distinguished name used to search the directory",
+			},
+
+			"bind_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of the bind DN",
+			},
+
+			"search_base_dn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Distinguished name the search for users starts at",
+			},
+
+			"search_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "sub",
+				ValidateFunc: validateStringValue([]string{"base", "one", "sub"}),
+				Description:  "Depth of the search for users",
+			},
+
+			"login_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "samaccountname",
+				Description: "Attribute matched against the login name",
+			},
+
+			"ssl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validateStringValue([]string{"enabled", "disabled", "start-tls"}),
+				Description:  "Encrypt the connection to the LDAP servers",
+			},
+
+			"ssl_ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Certificate authority used to verify the LDAP servers",
+			},
+
+			"ssl_check_peer": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validateEnabledDisabled,
+				Description:  "Verify the certificate of the LDAP servers",
+			},
+
+			"bind_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Seconds to wait for a bind",
+			},
+		},
+	}
+}
+
+func resourceBigipAuthLdapCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Creating LDAP Authentication")
+
+	l := dataToAuthLdap(d)
+	l.Name = authSystemAuth
+	err := client.CreateLdap(&l)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create LDAP Authentication (%v) ", err)
+		return err
+	}
+	d.SetId(authSystemAuth)
+
+	return resourceBigipAuthLdapRead(d, meta)
+}
+
+func resourceBigipAuthLdapRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Reading LDAP Authentication " + d.Id())
+
+	l, err := client.GetLdap(d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve LDAP Authentication (%v) ", err)
+		return err
+	}
+	if l == nil {
+		log.Printf("[WARN] LDAP Authentication (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// The bind password is only returned encrypted
+	if err := d.Set("servers", l.Servers); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Servers to state for LDAP Authentication (%s): %s", d.Id(), err)
+	}
+	d.Set("port", l.Port)
+	d.Set("bind_dn", l.BindDn)
+	d.Set("search_base_dn", l.SearchBaseDn)
+	d.Set("search_scope", l.SearchScope)
+	d.Set("login_attribute", l.LoginAttribute)
+	d.Set("ssl", l.Ssl)
+	d.Set("ssl_ca_cert_file", l.SslCaCertFile)
+	d.Set("ssl_check_peer", l.SslCheckPeer)
+	d.Set("bind_timeout", l.BindTimeout)
+
+	return nil
+}
+
+func resourceBigipAuthLdapUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Updating LDAP Authentication " + d.Id())
+
+	l := dataToAuthLdap(d)
+	err := client.ModifyLdap(d.Id(), &l)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update LDAP Authentication (%v) ", err)
+		return err
+	}
+
+	return resourceBigipAuthLdapRead(d, meta)
+}
+
+func resourceBigipAuthLdapDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Deleting LDAP Authentication " + d.Id())
+
+	err := client.DeleteLdap(d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete LDAP Authentication (%v) ", err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToAuthLdap(d *schema.ResourceData) bigip.Ldap {
+	return bigip.Ldap{
+		Servers:        listToStringSlice(d.Get("servers").([]interface{})),
+		Port:           d.Get("port").(int),
+		BindDn:         d.Get("bind_dn").(string),
+		BindPw:         d.Get("bind_password").(string),
+		SearchBaseDn:   d.Get("search_base_dn").(string),
+		SearchScope:    d.Get("search_scope").(string),
+		LoginAttribute: d.Get("login_attribute").(string),
+		Ssl:            d.Get("ssl").(string),
+		SslCaCertFile:  d.Get("ssl_ca_cert_file").(string),
+		SslCheckPeer:   d.Get("ssl_check_peer").(string),
+		BindTimeout:    d.Get("bind_timeout").(int),
+	}
+}
@@ -0,0 +1,71 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_AUTH_LDAP_RESOURCE = `
+resource "bigip_auth_ldap" "test" {
+	servers = ["10.10.10.50", "10.10.10.51"]
+	bind_dn = "cn=bigip,ou=services,dc=example,dc=com"
+	bind_password = "secret"
+	search_base_dn = "dc=example,dc=com"
+	login_attribute = "uid"
+}
+`
+
+func TestAccBigipAuthLdap_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAuthLdapDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_AUTH_LDAP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthLdapExists(true),
+					resource.TestCheckResourceAttr("bigip_auth_ldap.test", "servers.#", "2"),
+					resource.TestCheckResourceAttr("bigip_auth_ldap.test", "login_attribute", "uid"),
+					resource.TestCheckResourceAttr("bigip_auth_ldap.test", "port", "389"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAuthLdapExists(exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		c, err := client.GetLdap(authSystemAuth)
+		if err != nil {
+			return err
+		}
+		if exists && c == nil {
+			return fmt.Errorf("ldap authentication was not created.")
+		}
+		if !exists && c != nil {
+			return fmt.Errorf("ldap authentication still exists.")
+		}
+		return nil
+	}
+}
+
+func testCheckAuthLdapDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_auth_ldap" {
+			continue
+		}
+		if err := testCheckAuthLdapExists(false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipAuthRadius() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceBigipAuthRadiusCreate,
+		Read:          resourceBigipAuthRadiusRead,
+		Update:        resourceBigipAuthRadiusUpdate,
+		Delete:        resourceBigipAuthRadiusDelete,
+		CustomizeDiff: resourceBigipAuthRadiusCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"servers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    2,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Primary and optional secondary RADIUS server, see bigip_auth_radius_server",
+			},
+
+			"accounting": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "send-to-first-server",
+				ValidateFunc: validateStringValue([]string{"send-to-first-server", "send-to-all-servers"}),
+				Description:  "Servers accounting information is sent to",
+			},
+
+			"service_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "authenticate-only",
+				Description: "Service type sent in the authentication requests",
+			},
+
+			"retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3,
+				Description: "Number of retries before a server is considered unreachable",
+			},
+		},
+	}
+}
+
+func resourceBigipAuthRadiusCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Creating RADIUS Authentication")
+
+	r := dataToAuthRadius(d)
+	r.Name = authSystemAuth
+	err := client.CreateRadius(&r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create RADIUS Authentication (%v) ", err)
+		return err
+	}
+	d.SetId(authSystemAuth)
+
+	return resourceBigipAuthRadiusRead(d, meta)
+}
+
+func resourceBigipAuthRadiusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Reading RADIUS Authentication " + d.Id())
+
+	r, err := client.GetRadius(d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve RADIUS Authentication (%v) ", err)
+		return err
+	}
+	if r == nil {
+		log.Printf("[WARN] RADIUS Authentication (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("servers", r.Servers); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Servers to state for RADIUS Authentication (%s): %s", d.Id(), err)
+	}
+	d.Set("accounting", r.Accounting)
+	d.Set("service_type", r.ServiceType)
+	d.Set("retries", r.Retries)
+
+	return nil
+}
+
+func resourceBigipAuthRadiusUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Updating RADIUS Authentication " + d.Id())
+
+	r := dataToAuthRadius(d)
+	err := client.ModifyRadius(d.Id(), &r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update RADIUS Authentication (%v) ", err)
+		return err
+	}
+
+	return resourceBigipAuthRadiusRead(d, meta)
+}
+
+func resourceBigipAuthRadiusDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Deleting RADIUS Authentication " + d.Id())
+
+	err := client.DeleteRadius(d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete RADIUS Authentication (%v) ", err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceBigipAuthRadiusCustomizeDiff fails the plan when a server does not
+// exist. Servers created in the same run are not known yet and are skipped.
+func resourceBigipAuthRadiusCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("servers") {
+		return nil
+	}
+	client := meta.(*bigip.BigIP)
+
+	for i := range d.Get("servers").([]interface{}) {
+		key := fmt.Sprintf("servers.%d", i)
+		if !d.NewValueKnown(key) {
+			continue
+		}
+		name := d.Get(key).(string)
+		r, err := client.GetRadiusServer(name)
+		if err != nil {
+			return err
+		}
+		if r == nil {
+			return fmt.Errorf("RADIUS server %s does not exist", name)
+		}
+	}
+	return nil
+}
+
+func dataToAuthRadius(d *schema.ResourceData) bigip.Radius {
+	return bigip.Radius{
+		Servers:     listToStringSlice(d.Get("servers").([]interface{})),
+		Accounting:  d.Get("accounting").(string),
+		ServiceType: d.Get("service_type").(string),
+		Retries:     d.Get("retries").(int),
+	}
+}
@@ -0,0 +1,161 @@
+package bigip
+
+import (
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipAuthRadiusServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipAuthRadiusServerCreate,
+		Read:   resourceBigipAuthRadiusServerRead,
+		Update: resourceBigipAuthRadiusServerUpdate,
+		Delete: resourceBigipAuthRadiusServerDelete,
+		Exists: resourceBigipAuthRadiusServerExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the RADIUS server",
+				ValidateFunc: validateF5Name,
+			},
+
+			"server": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Address of the RADIUS server",
+			},
+
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1812,
+				Description: "Authentication port of the RADIUS server",
+			},
+
+			"secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Shared secret of the RADIUS server",
+			},
+
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3,
+				Description: "Seconds to wait for a response",
+			},
+		},
+	}
+}
+
+func resourceBigipAuthRadiusServerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating RADIUS Server " + name)
+
+	r := dataToAuthRadiusServer(name, d)
+	err := client.CreateRadiusServer(&r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create RADIUS Server (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipAuthRadiusServerRead(d, meta)
+}
+
+func resourceBigipAuthRadiusServerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading RADIUS Server " + name)
+
+	r, err := client.GetRadiusServer(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve RADIUS Server (%s) (%v) ", name, err)
+		return err
+	}
+	if r == nil {
+		log.Printf("[WARN] RADIUS Server (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// The secret is only returned encrypted
+	d.Set("name", name)
+	d.Set("server", r.Server)
+	d.Set("port", r.Port)
+	d.Set("timeout", r.Timeout)
+
+	return nil
+}
+
+func resourceBigipAuthRadiusServerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if RADIUS Server exists " + name)
+
+	r, err := client.GetRadiusServer(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve RADIUS Server (%s) (%v) ", name, err)
+		return false, err
+	}
+	if r == nil {
+		log.Printf("[WARN] RADIUS Server (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipAuthRadiusServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating RADIUS Server " + name)
+
+	r := dataToAuthRadiusServer(name, d)
+	err := client.ModifyRadiusServer(name, &r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update RADIUS Server (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipAuthRadiusServerRead(d, meta)
+}
+
+func resourceBigipAuthRadiusServerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting RADIUS Server " + name)
+
+	err := client.DeleteRadiusServer(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete RADIUS Server (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToAuthRadiusServer(name string, d *schema.ResourceData) bigip.RadiusServer {
+	return bigip.RadiusServer{
+		Name:    name,
+		Server:  d.Get("server").(string),
+		Port:    d.Get("port").(int),
+		Secret:  d.Get("secret").(string),
+		Timeout: d.Get("timeout").(int),
+	}
+}
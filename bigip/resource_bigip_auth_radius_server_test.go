@@ -0,0 +1,80 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_AUTH_RADIUS_SERVER_NAME = fmt.Sprintf("/%s/test-radius", TEST_PARTITION)
+
+var TEST_AUTH_RADIUS_SERVER_RESOURCE = `
+resource "bigip_auth_radius_server" "test" {
+	name = "` + TEST_AUTH_RADIUS_SERVER_NAME + `"
+	server = "10.10.10.60"
+	secret = "secret"
+	timeout = 5
+}
+`
+
+func TestAccBigipAuthRadiusServer_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAuthRadiusServersDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_AUTH_RADIUS_SERVER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthRadiusServerExists(TEST_AUTH_RADIUS_SERVER_NAME, true),
+					resource.TestCheckResourceAttr("bigip_auth_radius_server.test", "server", "10.10.10.60"),
+					resource.TestCheckResourceAttr("bigip_auth_radius_server.test", "port", "1812"),
+					resource.TestCheckResourceAttr("bigip_auth_radius_server.test", "timeout", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAuthRadiusServerExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		r, err := client.GetRadiusServer(name)
+		if err != nil {
+			return err
+		}
+		if exists && r == nil {
+			return fmt.Errorf("radius server %s was not created.", name)
+		}
+		if !exists && r != nil {
+			return fmt.Errorf("radius server %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckAuthRadiusServersDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_auth_radius_server" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		r, err := client.GetRadiusServer(name)
+		if err != nil {
+			return err
+		}
+		if r != nil {
+			return fmt.Errorf("radius server %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_AUTH_RADIUS_RESOURCE = `
+resource "bigip_auth_radius_server" "test" {
+	name = "` + TEST_AUTH_RADIUS_SERVER_NAME + `"
+	server = "10.10.10.60"
+	secret = "secret"
+}
+
+resource "bigip_auth_radius" "test" {
+	servers = ["${bigip_auth_radius_server.test.name}"]
+	retries = 5
+}
+`
+
+func TestAccBigipAuthRadius_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAuthRadiusDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_AUTH_RADIUS_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthRadiusExists(true),
+					resource.TestCheckResourceAttr("bigip_auth_radius.test", "servers.#", "1"),
+					resource.TestCheckResourceAttr("bigip_auth_radius.test", "retries", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAuthRadiusExists(exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		c, err := client.GetRadius(authSystemAuth)
+		if err != nil {
+			return err
+		}
+		if exists && c == nil {
+			return fmt.Errorf("radius authentication was not created.")
+		}
+		if !exists && c != nil {
+			return fmt.Errorf("radius authentication still exists.")
+		}
+		return nil
+	}
+}
+
+func testCheckAuthRadiusDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_auth_radius" {
+			continue
+		}
+		if err := testCheckAuthRadiusExists(false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
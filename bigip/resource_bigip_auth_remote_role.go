@@ -0,0 +1,179 @@
+package bigip
+
+import (
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipAuthRemoteRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipAuthRemoteRoleCreate,
+		Read:   resourceBigipAuthRemoteRoleRead,
+		Update: resourceBigipAuthRemoteRoleUpdate,
+		Delete: resourceBigipAuthRemoteRoleDelete,
+		Exists: resourceBigipAuthRemoteRoleExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role mapping",
+			},
+
+			"line_order": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Order in which the mappings are evaluated, the first matching mapping applies",
+			},
+
+			"attribute": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Attribute the remote users must have, e.g. memberOf=cn=admins,ou=groups,dc=example,dc=com",
+			},
+
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringValue(authRoles),
+				Description:  "Role of the matching users",
+			},
+
+			"user_partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Common",
+				Description: "Partition the role applies to, All for every partition",
+			},
+
+			"console": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validateStringValue([]string{"disabled", "tmsh"}),
+				Description:  "Terminal access of the matching users",
+			},
+
+			"deny": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validateEnabledDisabled,
+				Description:  "Deny access to the matching users",
+			},
+		},
+	}
+}
+
+func resourceBigipAuthRemoteRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating Remote Role " + name)
+
+	r := dataToAuthRemoteRole(name, d)
+	err := client.CreateRemoteRole(&r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Remote Role (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipAuthRemoteRoleRead(d, meta)
+}
+
+func resourceBigipAuthRemoteRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading Remote Role " + name)
+
+	r, err := client.GetRemoteRole(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Remote Role (%s) (%v) ", name, err)
+		return err
+	}
+	if r == nil {
+		log.Printf("[WARN] Remote Role (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("line_order", r.LineOrder)
+	d.Set("attribute", r.Attribute)
+	d.Set("role", r.Role)
+	d.Set("user_partition", r.UserPartition)
+	d.Set("console", r.Console)
+	d.Set("deny", r.Deny)
+
+	return nil
+}
+
+func resourceBigipAuthRemoteRoleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if Remote Role exists " + name)
+
+	r, err := client.GetRemoteRole(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Remote Role (%s) (%v) ", name, err)
+		return false, err
+	}
+	if r == nil {
+		log.Printf("[WARN] Remote Role (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipAuthRemoteRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating Remote Role " + name)
+
+	r := dataToAuthRemoteRole(name, d)
+	err := client.ModifyRemoteRole(name, &r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update Remote Role (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipAuthRemoteRoleRead(d, meta)
+}
+
+func resourceBigipAuthRemoteRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting Remote Role " + name)
+
+	err := client.DeleteRemoteRole(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Remote Role (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToAuthRemoteRole(name string, d *schema.ResourceData) bigip.RemoteRole {
+	return bigip.RemoteRole{
+		Name:          name,
+		LineOrder:     d.Get("line_order").(int),
+		Attribute:     d.Get("attribute").(string),
+		Role:          d.Get("role").(string),
+		UserPartition: d.Get("user_partition").(string),
+		Console:       d.Get("console").(string),
+		Deny:          d.Get("deny").(string),
+	}
+}
@@ -0,0 +1,103 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_AUTH_REMOTE_ROLE_NAME = "test-remote-role"
+
+var TEST_AUTH_REMOTE_ROLE_RESOURCE = `
+resource "bigip_auth_remote_role" "test-remote-role" {
+	name = "` + TEST_AUTH_REMOTE_ROLE_NAME + `"
+	line_order = 1000
+	attribute = "memberOf=cn=operators,ou=groups,dc=example,dc=com"
+	role = "operator"
+	user_partition = "` + TEST_PARTITION + `"
+	console = "tmsh"
+}
+`
+
+func TestAccBigipAuthRemoteRole_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAuthRemoteRolesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_AUTH_REMOTE_ROLE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthRemoteRoleExists(TEST_AUTH_REMOTE_ROLE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_auth_remote_role.test-remote-role", "line_order", "1000"),
+					resource.TestCheckResourceAttr("bigip_auth_remote_role.test-remote-role", "role", "operator"),
+					resource.TestCheckResourceAttr("bigip_auth_remote_role.test-remote-role", "console", "tmsh"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipAuthRemoteRole_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAuthRemoteRolesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_AUTH_REMOTE_ROLE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthRemoteRoleExists(TEST_AUTH_REMOTE_ROLE_NAME, true),
+				),
+				ResourceName:      TEST_AUTH_REMOTE_ROLE_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAuthRemoteRoleExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		r, err := client.GetRemoteRole(name)
+		if err != nil {
+			return err
+		}
+		if exists && r == nil {
+			return fmt.Errorf("remote role %s was not created.", name)
+		}
+		if !exists && r != nil {
+			return fmt.Errorf("remote role %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckAuthRemoteRolesDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_auth_remote_role" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		r, err := client.GetRemoteRole(name)
+		if err != nil {
+			return err
+		}
+		if r != nil {
+			return fmt.Errorf("remote role %s not destroyed.", name)
+		}
+	}
+	return nil
+}
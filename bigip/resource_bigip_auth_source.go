@@ -0,0 +1,93 @@
+package bigip
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipAuthSource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipAuthSourceCreate,
+		Read:   resourceBigipAuthSourceRead,
+		Update: resourceBigipAuthSourceUpdate,
+		Delete: resourceBigipAuthSourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateStringValue([]string{"local", "ldap", "active-directory", "radius", "tacacs"}),
+				Description:  "Authentication source used for users that are not local",
+			},
+
+			"fallback": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Allow local users to log in when the remote servers are unreachable",
+			},
+		},
+	}
+}
+
+func resourceBigipAuthSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Creating Authentication Source")
+
+	d.SetId("source")
+	return resourceBigipAuthSourceUpdate(d, meta)
+}
+
+func resourceBigipAuthSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Reading Authentication Source")
+
+	s, err := client.GetAuthSource()
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Authentication Source (%v) ", err)
+		return err
+	}
+
+	d.Set("type", s.Type)
+	d.Set("fallback", s.Fallback == "true")
+
+	return nil
+}
+
+func resourceBigipAuthSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Updating Authentication Source")
+
+	s := &bigip.AuthSource{
+		Type:     d.Get("type").(string),
+		Fallback: strconv.FormatBool(d.Get("fallback").(bool)),
+	}
+	err := client.ModifyAuthSource(s)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update Authentication Source (%v) ", err)
+		return err
+	}
+
+	return resourceBigipAuthSourceRead(d, meta)
+}
+
+func resourceBigipAuthSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Resetting Authentication Source to local")
+
+	err := client.ModifyAuthSource(&bigip.AuthSource{Type: "local", Fallback: "false"})
+	if err != nil {
+		log.Printf("[ERROR] Unable to Reset Authentication Source (%v) ", err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,55 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_AUTH_SOURCE_RESOURCE = `
+resource "bigip_auth_tacacs" "test" {
+	servers = ["10.10.10.70"]
+	secret = "secret"
+}
+
+resource "bigip_auth_source" "test" {
+	type = "tacacs"
+	fallback = true
+	depends_on = ["bigip_auth_tacacs.test"]
+}
+`
+
+func TestAccBigipAuthSource_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAuthSourceReset,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_AUTH_SOURCE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_auth_source.test", "type", "tacacs"),
+					resource.TestCheckResourceAttr("bigip_auth_source.test", "fallback", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAuthSourceReset(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	source, err := client.GetAuthSource()
+	if err != nil {
+		return err
+	}
+	if source.Type != "local" {
+		return fmt.Errorf("authentication source is still %s.", source.Type)
+	}
+	return nil
+}
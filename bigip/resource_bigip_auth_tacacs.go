@@ -0,0 +1,162 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipAuthTacacs() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipAuthTacacsCreate,
+		Read:   resourceBigipAuthTacacsRead,
+		Update: resourceBigipAuthTacacsUpdate,
+		Delete: resourceBigipAuthTacacsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"servers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Addresses of the TACACS+ servers in order",
+			},
+
+			"secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Shared secret of the TACACS+ servers",
+			},
+
+			"service": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ppp",
+				Description: "Service name sent in the authorization requests",
+			},
+
+			"protocol": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ip",
+				Description: "Protocol sent in the authorization requests",
+			},
+
+			"encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				ValidateFunc: validateEnabledDisabled,
+				Description:  "Encrypt the packets to the TACACS+ servers",
+			},
+
+			"authentication": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "use-first-server",
+				ValidateFunc: validateStringValue([]string{"use-first-server", "use-all-servers"}),
+				Description:  "Try only the first reachable server or every server",
+			},
+
+			"accounting": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "send-to-first-server",
+				ValidateFunc: validateStringValue([]string{"send-to-first-server", "send-to-all-servers"}),
+				Description:  "Servers accounting information is sent to",
+			},
+		},
+	}
+}
+
+func resourceBigipAuthTacacsCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Creating TACACS+ Authentication")
+
+	t := dataToAuthTacacs(d)
+	t.Name = authSystemAuth
+	err := client.CreateTacacs(&t)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create TACACS+ Authentication (%v) ", err)
+		return err
+	}
+	d.SetId(authSystemAuth)
+
+	return resourceBigipAuthTacacsRead(d, meta)
+}
+
+func resourceBigipAuthTacacsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Reading TACACS+ Authentication " + d.Id())
+
+	t, err := client.GetTacacs(d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve TACACS+ Authentication (%v) ", err)
+		return err
+	}
+	if t == nil {
+		log.Printf("[WARN] TACACS+ Authentication (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// The secret is only returned encrypted
+	if err := d.Set("servers", t.Servers); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Servers to state for TACACS+ Authentication (%s): %s", d.Id(), err)
+	}
+	d.Set("service", t.Service)
+	d.Set("protocol", t.Protocol)
+	d.Set("encryption", t.Encryption)
+	d.Set("authentication", t.Authentication)
+	d.Set("accounting", t.Accounting)
+
+	return nil
+}
+
+func resourceBigipAuthTacacsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Updating TACACS+ Authentication " + d.Id())
+
+	t := dataToAuthTacacs(d)
+	err := client.ModifyTacacs(d.Id(), &t)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update TACACS+ Authentication (%v) ", err)
+		return err
+	}
+
+	return resourceBigipAuthTacacsRead(d, meta)
+}
+
+func resourceBigipAuthTacacsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Deleting TACACS+ Authentication " + d.Id())
+
+	err := client.DeleteTacacs(d.Id())
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete TACACS+ Authentication (%v) ", err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToAuthTacacs(d *schema.ResourceData) bigip.Tacacs {
+	return bigip.Tacacs{
+		Servers:        listToStringSlice(d.Get("servers").([]interface{})),
+		Secret:         d.Get("secret").(string),
+		Service:        d.Get("service").(string),
+		Protocol:       d.Get("protocol").(string),
+		Encryption:     d.Get("encryption").(string),
+		Authentication: d.Get("authentication").(string),
+		Accounting:     d.Get("accounting").(string),
+	}
+}
@@ -0,0 +1,68 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_AUTH_TACACS_RESOURCE = `
+resource "bigip_auth_tacacs" "test" {
+	servers = ["10.10.10.70"]
+	secret = "secret"
+	authentication = "use-all-servers"
+}
+`
+
+func TestAccBigipAuthTacacs_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAuthTacacsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_AUTH_TACACS_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthTacacsExists(true),
+					resource.TestCheckResourceAttr("bigip_auth_tacacs.test", "servers.#", "1"),
+					resource.TestCheckResourceAttr("bigip_auth_tacacs.test", "authentication", "use-all-servers"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAuthTacacsExists(exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		c, err := client.GetTacacs(authSystemAuth)
+		if err != nil {
+			return err
+		}
+		if exists && c == nil {
+			return fmt.Errorf("tacacs authentication was not created.")
+		}
+		if !exists && c != nil {
+			return fmt.Errorf("tacacs authentication still exists.")
+		}
+		return nil
+	}
+}
+
+func testCheckAuthTacacsDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_auth_tacacs" {
+			continue
+		}
+		if err := testCheckAuthTacacsExists(false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var authRoles = []string{"admin", "resource-admin", "user-manager", "auditor", "manager",
+	"application-editor", "operator", "certificate-manager", "irule-manager", "guest",
+	"web-application-security-administrator", "web-application-security-editor",
+	"firewall-manager", "fraud-protection-manager", "no-access"}
+
+func resourceBigipAuthUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipAuthUserCreate,
+		Read:   resourceBigipAuthUserRead,
+		Update: resourceBigipAuthUserUpdate,
+		Delete: resourceBigipAuthUserDelete,
+		Exists: resourceBigipAuthUserExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the user",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description, usually the full name",
+			},
+
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of the user",
+			},
+
+			"shell": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "none",
+				ValidateFunc: validateStringValue([]string{"none", "tmsh", "bash"}),
+				Description:  "Terminal access of the user",
+			},
+
+			"partition_access": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Roles of the user in partitions",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the partition, all-partitions grants the role in every partition",
+						},
+						"role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateStringValue(authRoles),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipAuthUserCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating User " + name)
+
+	u := dataToAuthUser(name, d)
+	err := client.CreateUser(&u)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create User (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipAuthUserRead(d, meta)
+}
+
+func resourceBigipAuthUserRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading User " + name)
+
+	u, err := client.GetUser(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve User (%s) (%v) ", name, err)
+		return err
+	}
+	if u == nil {
+		log.Printf("[WARN] User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("description", u.Description)
+	d.Set("shell", u.Shell)
+
+	var access []map[string]interface{}
+	for _, a := range u.PartitionAccess {
+		access = append(access, map[string]interface{}{
+			"partition": a.Name,
+			"role":      a.Role,
+		})
+	}
+	if err := d.Set("partition_access", access); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Partition Access to state for User (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBigipAuthUserExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if User exists " + name)
+
+	u, err := client.GetUser(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve User (%s) (%v) ", name, err)
+		return false, err
+	}
+	if u == nil {
+		log.Printf("[WARN] User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipAuthUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating User " + name)
+
+	u := dataToAuthUser(name, d)
+	// Only send the password when it changed, setting it again would
+	// expire the password of users with a password policy.
+	if !d.HasChange("password") {
+		u.Password = ""
+	}
+	err := client.ModifyUser(name, &u)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update User (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipAuthUserRead(d, meta)
+}
+
+func resourceBigipAuthUserDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting User " + name)
+
+	err := client.DeleteUser(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete User (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToAuthUser(name string, d *schema.ResourceData) bigip.User {
+	u := bigip.User{
+		Name:        name,
+		Description: d.Get("description").(string),
+		Password:    d.Get("password").(string),
+		Shell:       d.Get("shell").(string),
+	}
+	for _, a := range d.Get("partition_access").(*schema.Set).List() {
+		access := a.(map[string]interface{})
+		u.PartitionAccess = append(u.PartitionAccess, bigip.PartitionAccess{
+			Name: access["partition"].(string),
+			Role: access["role"].(string),
+		})
+	}
+	return u
+}
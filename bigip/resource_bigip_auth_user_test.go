@@ -0,0 +1,105 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_AUTH_USER_NAME = "test-user"
+
+var TEST_AUTH_USER_RESOURCE = `
+resource "bigip_auth_user" "test-user" {
+	name = "` + TEST_AUTH_USER_NAME + `"
+	description = "Test User"
+	password = "Test-Passw0rd!"
+	shell = "tmsh"
+	partition_access {
+		partition = "` + TEST_PARTITION + `"
+		role = "operator"
+	}
+}
+`
+
+func TestAccBigipAuthUser_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAuthUsersDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_AUTH_USER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthUserExists(TEST_AUTH_USER_NAME, true),
+					resource.TestCheckResourceAttr("bigip_auth_user.test-user", "name", TEST_AUTH_USER_NAME),
+					resource.TestCheckResourceAttr("bigip_auth_user.test-user", "shell", "tmsh"),
+					resource.TestCheckResourceAttr("bigip_auth_user.test-user", "partition_access.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipAuthUser_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAuthUsersDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_AUTH_USER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAuthUserExists(TEST_AUTH_USER_NAME, true),
+				),
+				ResourceName:      TEST_AUTH_USER_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAuthUserExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		u, err := client.GetUser(name)
+		if err != nil {
+			return err
+		}
+		if exists && u == nil {
+			return fmt.Errorf("user %s was not created.", name)
+		}
+		if !exists && u != nil {
+			return fmt.Errorf("user %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckAuthUsersDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_auth_user" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		u, err := client.GetUser(name)
+		if err != nil {
+			return err
+		}
+		if u != nil {
+			return fmt.Errorf("user %s not destroyed.", name)
+		}
+	}
+	return nil
+}
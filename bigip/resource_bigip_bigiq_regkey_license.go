@@ -0,0 +1,118 @@
+package bigip
+
+import (
+	"log"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipBigiqRegkeyLicense() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipBigiqRegkeyLicenseCreate,
+		Read:   resourceBigipBigiqRegkeyLicenseRead,
+		Delete: resourceBigipBigiqRegkeyLicenseDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: bigiqLicenseSchema(map[string]*schema.Schema{
+			"pool_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the BIG-IQ purchased (regkey) license pool",
+			},
+			"pool_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Uuid of the license pool",
+			},
+		}),
+	}
+}
+
+func resourceBigipBigiqRegkeyLicenseCreate(d *schema.ResourceData, meta interface{}) error {
+	bigiq, err := bigiqClient(d)
+	if err != nil {
+		return err
+	}
+
+	poolName := d.Get("pool_name").(string)
+	log.Println("[INFO] Assigning license from BIG-IQ pool " + poolName)
+
+	poolUuid, err := bigiq.GetLicensePoolUuid(poolName)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve License Pool (%s) (%v) ", poolName, err)
+		return err
+	}
+	d.Set("pool_uuid", poolUuid)
+
+	m, err := bigiq.AssignLicensePoolMember(poolUuid, bigiqLicenseMember(d, meta))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Assign License from Pool (%s) (%v) ", poolName, err)
+		return err
+	}
+	d.SetId(m.Id)
+
+	err = waitForBigiqLicense(d, func() (*bigip.LicensePoolMember, error) {
+		return bigiq.GetLicensePoolMember(poolUuid, m.Id)
+	})
+	if err != nil {
+		log.Printf("[ERROR] Unable to License BIG-IP from Pool (%s) (%v) ", poolName, err)
+		return err
+	}
+
+	return resourceBigipBigiqRegkeyLicenseRead(d, meta)
+}
+
+func resourceBigipBigiqRegkeyLicenseRead(d *schema.ResourceData, meta interface{}) error {
+	bigiq, err := bigiqClient(d)
+	if err != nil {
+		return err
+	}
+
+	id := d.Id()
+	log.Println("[INFO] Reading License Assignment " + id)
+
+	m, err := bigiq.GetLicensePoolMember(d.Get("pool_uuid").(string), id)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve License Assignment (%s) (%v) ", id, err)
+		return err
+	}
+	if m == nil {
+		log.Printf("[WARN] License Assignment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("device_address", m.DeviceAddress)
+	d.Set("status", m.Status)
+
+	return nil
+}
+
+func resourceBigipBigiqRegkeyLicenseDelete(d *schema.ResourceData, meta interface{}) error {
+	bigiq, err := bigiqClient(d)
+	if err != nil {
+		return err
+	}
+
+	id := d.Id()
+	log.Println("[INFO] Revoking License Assignment " + id)
+
+	m := &bigip.LicensePoolMember{
+		Id:       id,
+		Username: d.Get("device_username").(string),
+		Password: d.Get("device_password").(string),
+	}
+	err = bigiq.RevokeLicensePoolMember(d.Get("pool_uuid").(string), m)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Revoke License Assignment (%s) (%v) ", id, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,99 @@
+package bigip
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_BIGIQ_REGKEY_LICENSE_RESOURCE = `
+resource "bigip_bigiq_regkey_license" "test-license" {
+	bigiq_address = "` + os.Getenv("BIGIQ_HOST") + `"
+	bigiq_user = "` + os.Getenv("BIGIQ_USER") + `"
+	bigiq_password = "` + os.Getenv("BIGIQ_PASSWORD") + `"
+	pool_name = "` + os.Getenv("BIGIQ_LICENSE_POOL") + `"
+}
+`
+
+func TestAccBigipBigiqRegkeyLicense_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+			testBigiqPreCheck(t, "BIGIQ_LICENSE_POOL")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigiqLicensesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_BIGIQ_REGKEY_LICENSE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigiqLicenseExists("bigip_bigiq_regkey_license.test-license", true),
+					resource.TestCheckResourceAttr("bigip_bigiq_regkey_license.test-license", "status", "LICENSED"),
+					resource.TestCheckResourceAttrSet("bigip_bigiq_regkey_license.test-license", "pool_uuid"),
+				),
+			},
+		},
+	})
+}
+
+// testBigiqPreCheck skips the test when no BIG-IQ is available to license from
+func testBigiqPreCheck(t *testing.T, vars ...string) {
+	for _, s := range append([]string{"BIGIQ_HOST", "BIGIQ_USER", "BIGIQ_PASSWORD"}, vars...) {
+		if os.Getenv(s) == "" {
+			t.Skipf("%s must be set for BIG-IQ license tests", s)
+		}
+	}
+}
+
+func testBigiqLicenseMember(rs *terraform.ResourceState) (*bigip.LicensePoolMember, error) {
+	bigiq := bigip.NewSession(os.Getenv("BIGIQ_HOST"), os.Getenv("BIGIQ_USER"), os.Getenv("BIGIQ_PASSWORD"), nil)
+	switch rs.Type {
+	case "bigip_bigiq_regkey_license":
+		return bigiq.GetLicensePoolMember(rs.Primary.Attributes["pool_uuid"], rs.Primary.ID)
+	case "bigip_bigiq_utility_license":
+		return bigiq.GetUtilityLicense(rs.Primary.Attributes["registration_key"], rs.Primary.Attributes["offering_id"], rs.Primary.ID)
+	}
+	return nil, nil
+}
+
+func testCheckBigiqLicenseExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		m, err := testBigiqLicenseMember(rs)
+		if err != nil {
+			return err
+		}
+		if exists && m == nil {
+			return fmt.Errorf("license assignment %s was not created.", rs.Primary.ID)
+		}
+		if !exists && m != nil {
+			return fmt.Errorf("license assignment %s still exists.", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testCheckBigiqLicensesDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_bigiq_regkey_license" && rs.Type != "bigip_bigiq_utility_license" {
+			continue
+		}
+
+		m, err := testBigiqLicenseMember(rs)
+		if err != nil {
+			return err
+		}
+		if m != nil {
+			return fmt.Errorf("license assignment %s not revoked.", rs.Primary.ID)
+		}
+	}
+	return nil
+}
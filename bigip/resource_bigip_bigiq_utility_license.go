@@ -0,0 +1,138 @@
+package bigip
+
+import (
+	"log"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipBigiqUtilityLicense() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipBigiqUtilityLicenseCreate,
+		Read:   resourceBigipBigiqUtilityLicenseRead,
+		Delete: resourceBigipBigiqUtilityLicenseDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: bigiqLicenseSchema(map[string]*schema.Schema{
+			"registration_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Registration key of the BIG-IQ utility license",
+			},
+			"offering": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the offering of the utility license, e.g. F5-BIG-MSP-BT-1G",
+			},
+			"unit_of_measure": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "hourly",
+				ValidateFunc: validateStringValue([]string{"hourly", "daily", "monthly", "yearly"}),
+				Description:  "Billing interval of the license",
+			},
+			"offering_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the offering",
+			},
+		}),
+	}
+}
+
+func resourceBigipBigiqUtilityLicenseCreate(d *schema.ResourceData, meta interface{}) error {
+	bigiq, err := bigiqClient(d)
+	if err != nil {
+		return err
+	}
+
+	regKey := d.Get("registration_key").(string)
+	offering := d.Get("offering").(string)
+	log.Println("[INFO] Assigning utility license " + regKey + " :: " + offering)
+
+	offeringId, err := bigiq.GetUtilityOfferingId(regKey, offering)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Utility License Offering (%s) (%v) ", offering, err)
+		return err
+	}
+	d.Set("offering_id", offeringId)
+
+	config := bigiqLicenseMember(d, meta)
+	config.UnitOfMeasure = d.Get("unit_of_measure").(string)
+	m, err := bigiq.AssignUtilityLicense(regKey, offeringId, config)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Assign Utility License (%s) (%v) ", offering, err)
+		return err
+	}
+	d.SetId(m.Id)
+
+	err = waitForBigiqLicense(d, func() (*bigip.LicensePoolMember, error) {
+		return bigiq.GetUtilityLicense(regKey, offeringId, m.Id)
+	})
+	if err != nil {
+		log.Printf("[ERROR] Unable to License BIG-IP from Utility License (%s) (%v) ", offering, err)
+		return err
+	}
+
+	return resourceBigipBigiqUtilityLicenseRead(d, meta)
+}
+
+func resourceBigipBigiqUtilityLicenseRead(d *schema.ResourceData, meta interface{}) error {
+	bigiq, err := bigiqClient(d)
+	if err != nil {
+		return err
+	}
+
+	id := d.Id()
+	log.Println("[INFO] Reading Utility License Assignment " + id)
+
+	m, err := bigiq.GetUtilityLicense(d.Get("registration_key").(string), d.Get("offering_id").(string), id)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Utility License Assignment (%s) (%v) ", id, err)
+		return err
+	}
+	if m == nil {
+		log.Printf("[WARN] Utility License Assignment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("device_address", m.DeviceAddress)
+	d.Set("status", m.Status)
+	if m.UnitOfMeasure != "" {
+		d.Set("unit_of_measure", m.UnitOfMeasure)
+	}
+
+	return nil
+}
+
+func resourceBigipBigiqUtilityLicenseDelete(d *schema.ResourceData, meta interface{}) error {
+	bigiq, err := bigiqClient(d)
+	if err != nil {
+		return err
+	}
+
+	id := d.Id()
+	log.Println("[INFO] Revoking Utility License Assignment " + id)
+
+	m := &bigip.LicensePoolMember{
+		Id:       id,
+		Username: d.Get("device_username").(string),
+		Password: d.Get("device_password").(string),
+	}
+	err = bigiq.RevokeUtilityLicense(d.Get("registration_key").(string), d.Get("offering_id").(string), m)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Revoke Utility License Assignment (%s) (%v) ", id, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
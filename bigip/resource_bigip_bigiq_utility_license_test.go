@@ -0,0 +1,40 @@
+package bigip
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+var TEST_BIGIQ_UTILITY_LICENSE_RESOURCE = `
+resource "bigip_bigiq_utility_license" "test-license" {
+	bigiq_address = "` + os.Getenv("BIGIQ_HOST") + `"
+	bigiq_user = "` + os.Getenv("BIGIQ_USER") + `"
+	bigiq_password = "` + os.Getenv("BIGIQ_PASSWORD") + `"
+	registration_key = "` + os.Getenv("BIGIQ_UTILITY_REGKEY") + `"
+	offering = "` + os.Getenv("BIGIQ_UTILITY_OFFERING") + `"
+	unit_of_measure = "hourly"
+}
+`
+
+func TestAccBigipBigiqUtilityLicense_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+			testBigiqPreCheck(t, "BIGIQ_UTILITY_REGKEY", "BIGIQ_UTILITY_OFFERING")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigiqLicensesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_BIGIQ_UTILITY_LICENSE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigiqLicenseExists("bigip_bigiq_utility_license.test-license", true),
+					resource.TestCheckResourceAttr("bigip_bigiq_utility_license.test-license", "status", "LICENSED"),
+					resource.TestCheckResourceAttr("bigip_bigiq_utility_license.test-license", "unit_of_measure", "hourly"),
+				),
+			},
+		},
+	})
+}
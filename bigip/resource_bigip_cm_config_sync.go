@@ -0,0 +1,140 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The status reported right after a sync was started may still be the one
+// before the sync, e.g. Changes Pending.
+const syncSettleTime = 30 * time.Second
+
+func resourceBigipCmConfigSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipCmConfigSyncCreate,
+		Read:   resourceBigipCmConfigSyncRead,
+		Delete: resourceBigipCmConfigSyncDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the device group to synchronize",
+			},
+
+			"direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      bigip.SyncToGroup,
+				ForceNew:     true,
+				Description:  "to-group pushes the configuration of the BIG-IP to the group, from-group pulls it from the group",
+				ValidateFunc: validateStringValue([]string{bigip.SyncToGroup, bigip.SyncFromGroup}),
+			},
+
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values, changing them synchronizes the device group again",
+			},
+
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Sync status of the device group, e.g. In Sync",
+			},
+
+			"summary": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Message reported with the sync status",
+			},
+		},
+	}
+}
+
+func resourceBigipCmConfigSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	group := d.Get("device_group").(string)
+	log.Println("[INFO] Synchronizing device group " + group)
+
+	err := client.ConfigSync(d.Get("direction").(string), group)
+	if err == nil {
+		err = waitForConfigSync(client, group, d.Timeout(schema.TimeoutCreate))
+	}
+	if err != nil {
+		log.Printf("[ERROR] Unable to Synchronize device group (%s) (%v) ", group, err)
+		return err
+	}
+	d.SetId(group)
+
+	return resourceBigipCmConfigSyncRead(d, meta)
+}
+
+// The sync status isn't part of the configuration, a group out of sync is
+// reported but doesn't synchronize it again.
+func resourceBigipCmConfigSyncRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	group := d.Id()
+	log.Println("[INFO] Reading sync status of device group " + group)
+
+	sync, err := client.GetSyncStatus()
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve sync status (%s) (%v) ", group, err)
+		return err
+	}
+	status, summary := sync.GroupStatus(group)
+	d.Set("status", status)
+	d.Set("summary", summary)
+
+	return nil
+}
+
+func resourceBigipCmConfigSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	// A sync can't be undone, the resource is only removed from the state
+
+	d.SetId("")
+	return nil
+}
+
+// waitForConfigSync polls cm/sync-status until the device group is in sync.
+func waitForConfigSync(client *bigip.BigIP, group string, timeout time.Duration) error {
+	start := time.Now()
+	return resource.Retry(timeout, func() *resource.RetryError {
+		sync, err := client.GetSyncStatus()
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		status, summary := sync.GroupStatus(group)
+		return configSyncStatus(group, status, summary, time.Since(start))
+	})
+}
+
+// configSyncStatus decides whether to keep waiting for the device group to
+// get in sync. Changes Pending and Disconnected are only final once the sync
+// had time to start.
+func configSyncStatus(group, status, summary string, elapsed time.Duration) *resource.RetryError {
+	switch status {
+	case bigip.SyncInSync:
+		return nil
+	case "Sync Failure":
+		return resource.NonRetryableError(fmt.Errorf("device group %s: %s: %s", group, status, summary))
+	case "Changes Pending", "Disconnected":
+		if elapsed >= syncSettleTime {
+			return resource.NonRetryableError(fmt.Errorf("device group %s: %s: %s", group, status, summary))
+		}
+	}
+	return resource.RetryableError(fmt.Errorf("device group %s is %s", group, status))
+}
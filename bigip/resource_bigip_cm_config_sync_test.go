@@ -0,0 +1,99 @@
+package bigip
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The test needs a sync-failover device group of trusted devices
+var TEST_SYNC_DEVICE_GROUP = os.Getenv("TEST_SYNC_DEVICE_GROUP")
+
+var TEST_CONFIG_SYNC_RESOURCE = `
+resource "bigip_cm_config_sync" "test-config-sync" {
+	device_group = "` + TEST_SYNC_DEVICE_GROUP + `"
+
+	triggers {
+		run = "1"
+	}
+}
+`
+
+func TestAccBigipCmConfigSync_create(t *testing.T) {
+	if TEST_SYNC_DEVICE_GROUP == "" {
+		t.Skip("TEST_SYNC_DEVICE_GROUP not set")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_CONFIG_SYNC_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckConfigSynced(TEST_SYNC_DEVICE_GROUP),
+					resource.TestCheckResourceAttr("bigip_cm_config_sync.test-config-sync", "status", bigip.SyncInSync),
+				),
+			},
+		},
+	})
+}
+
+func TestConfigSyncStatus(t *testing.T) {
+	sync := &bigip.SyncStatus{
+		Status:  "Changes Pending",
+		Summary: "There is a possible change conflict",
+		Details: []string{
+			"bigip2.local: connected (for 1093 seconds)",
+			"device_trust_group (In Sync): All devices in the device group are in sync",
+			"failover-group (Changes Pending): Changes pending on bigip1.local",
+		},
+	}
+	status, summary := sync.GroupStatus("failover-group")
+	if status != "Changes Pending" || summary != "Changes pending on bigip1.local" {
+		t.Errorf("unexpected status of failover-group %q: %q", status, summary)
+	}
+	if status, _ := sync.GroupStatus("/Common/device_trust_group"); status != bigip.SyncInSync {
+		t.Errorf("unexpected status of device_trust_group %q", status)
+	}
+
+	if err := configSyncStatus("failover-group", status, summary, time.Second); err == nil || !err.Retryable {
+		t.Errorf("pending changes right after the sync should be retried, got %v", err)
+	}
+	err := configSyncStatus("failover-group", status, summary, time.Minute)
+	if err == nil || err.Retryable {
+		t.Fatalf("pending changes after the sync should fail, got %v", err)
+	}
+	expected := "device group failover-group: Changes Pending: Changes pending on bigip1.local"
+	if err.Err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Err)
+	}
+
+	if err := configSyncStatus("failover-group", "Syncing", "", time.Minute); err == nil || !err.Retryable {
+		t.Errorf("running sync should be retried, got %v", err)
+	}
+	if err := configSyncStatus("failover-group", bigip.SyncInSync, "", time.Second); err != nil {
+		t.Errorf("group in sync should succeed, got %v", err)
+	}
+}
+
+func testCheckConfigSynced(group string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		sync, err := client.GetSyncStatus()
+		if err != nil {
+			return err
+		}
+		if status, summary := sync.GroupStatus(group); status != bigip.SyncInSync {
+			return fmt.Errorf("Device group %s is %s: %s", group, status, summary)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,171 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipCmDeviceFailover() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipCmDeviceFailoverCreate,
+		Read:   resourceBigipCmDeviceFailoverRead,
+		Update: resourceBigipCmDeviceFailoverUpdate,
+		Delete: resourceBigipCmDeviceFailoverDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the device, e.g. /Common/bigip1.local",
+				ValidateFunc: validateF5Name,
+			},
+
+			"unicast_address": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Addresses the peers send network failover heartbeats to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Self IP address of the device, or management-ip",
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1026,
+							Description: "UDP port of the heartbeats",
+						},
+					},
+				},
+			},
+
+			"multicast_interface": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Interface the multicast heartbeats are sent on, e.g. eth0, or none to disable multicast failover",
+			},
+
+			"multicast_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Multicast group of the heartbeats",
+			},
+
+			"multicast_port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "UDP port of the multicast heartbeats",
+			},
+		},
+	}
+}
+
+// The device always exists, creating the resource configures its failover
+// addresses.
+func resourceBigipCmDeviceFailoverCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	device := d.Get("device").(string)
+	log.Println("[INFO] Creating failover configuration of device " + device)
+
+	err := client.ModifyDeviceFailover(device, dataToDeviceFailover(d))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create failover configuration of device (%s) (%v) ", device, err)
+		return err
+	}
+	d.SetId(device)
+
+	return resourceBigipCmDeviceFailoverRead(d, meta)
+}
+
+func resourceBigipCmDeviceFailoverRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	device := d.Id()
+	log.Println("[INFO] Reading failover configuration of device " + device)
+
+	failover, err := client.GetDeviceFailover(device)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve failover configuration of device (%s) (%v) ", device, err)
+		return err
+	}
+	if failover == nil {
+		log.Printf("[WARN] Device (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("device", device)
+	if err := d.Set("unicast_address", flattenUnicastAddresses(failover.UnicastAddress)); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving unicast_address to state for device (%s): %s", d.Id(), err)
+	}
+	d.Set("multicast_interface", failover.MulticastInterface)
+	d.Set("multicast_ip", failover.MulticastIp)
+	d.Set("multicast_port", failover.MulticastPort)
+
+	return nil
+}
+
+func resourceBigipCmDeviceFailoverUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	device := d.Id()
+	log.Println("[INFO] Updating failover configuration of device " + device)
+
+	err := client.ModifyDeviceFailover(device, dataToDeviceFailover(d))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update failover configuration of device (%s) (%v) ", device, err)
+		return err
+	}
+
+	return resourceBigipCmDeviceFailoverRead(d, meta)
+}
+
+// Removing the failover addresses would trigger a failover, destroying the
+// resource only removes it from the state.
+func resourceBigipCmDeviceFailoverDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Failover configuration of device (%s) is kept, removing from state only", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func dataToDeviceFailover(d *schema.ResourceData) *bigip.DeviceFailover {
+	var addresses bigip.UnicastAddresses
+	for _, a := range d.Get("unicast_address").([]interface{}) {
+		address := a.(map[string]interface{})
+		addresses = append(addresses, bigip.UnicastAddress{
+			Ip:   address["ip"].(string),
+			Port: address["port"].(int),
+		})
+	}
+	return &bigip.DeviceFailover{
+		UnicastAddress:     addresses,
+		MulticastInterface: d.Get("multicast_interface").(string),
+		MulticastIp:        d.Get("multicast_ip").(string),
+		MulticastPort:      d.Get("multicast_port").(int),
+	}
+}
+
+func flattenUnicastAddresses(addresses bigip.UnicastAddresses) []interface{} {
+	result := make([]interface{}, 0, len(addresses))
+	for _, address := range addresses {
+		result = append(result, map[string]interface{}{
+			"ip":   address.Ip,
+			"port": address.Port,
+		})
+	}
+	return result
+}
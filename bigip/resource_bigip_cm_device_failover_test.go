@@ -0,0 +1,93 @@
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+// The device under test and one of its self IPs, the failover addresses of the
+// device are changed by the test.
+var TEST_FAILOVER_DEVICE = os.Getenv("TEST_FAILOVER_DEVICE")
+var TEST_FAILOVER_IP = os.Getenv("TEST_FAILOVER_IP")
+
+var TEST_DEVICE_FAILOVER_RESOURCE = `
+resource "bigip_cm_device_failover" "test-device-failover" {
+	device = "` + TEST_FAILOVER_DEVICE + `"
+
+	unicast_address {
+		ip = "` + TEST_FAILOVER_IP + `"
+	}
+
+	unicast_address {
+		ip   = "management-ip"
+		port = 1026
+	}
+}
+`
+
+func TestAccBigipCmDeviceFailover_create(t *testing.T) {
+	if TEST_FAILOVER_DEVICE == "" || TEST_FAILOVER_IP == "" {
+		t.Skip("TEST_FAILOVER_DEVICE or TEST_FAILOVER_IP not set")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DEVICE_FAILOVER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDeviceFailover(TEST_FAILOVER_DEVICE, TEST_FAILOVER_IP),
+					resource.TestCheckResourceAttr("bigip_cm_device_failover.test-device-failover", "unicast_address.#", "2"),
+					resource.TestCheckResourceAttr("bigip_cm_device_failover.test-device-failover", "unicast_address.0.port", "1026"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnicastAddresses(t *testing.T) {
+	var failover bigip.DeviceFailover
+	err := json.Unmarshal([]byte(`{"name":"bigip1.local","unicastAddress":"none"}`), &failover)
+	assert.NoError(t, err)
+	assert.Empty(t, flattenUnicastAddresses(failover.UnicastAddress))
+
+	err = json.Unmarshal([]byte(`{"name":"bigip1.local","unicastAddress":[{"effectiveIp":"10.1.20.1","effectivePort":1026,"ip":"10.1.20.1","port":1026}]}`), &failover)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"ip": "10.1.20.1", "port": 1026},
+	}, flattenUnicastAddresses(failover.UnicastAddress))
+
+	// Removing all addresses sends none
+	data, err := json.Marshal(&bigip.DeviceFailover{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"unicastAddress":"none"}`, string(data))
+}
+
+func testCheckDeviceFailover(device, ip string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		failover, err := client.GetDeviceFailover(device)
+		if err != nil {
+			return err
+		}
+		if failover == nil {
+			return fmt.Errorf("Device %s does not exist.", device)
+		}
+		for _, address := range failover.UnicastAddress {
+			if address.Ip == ip {
+				return nil
+			}
+		}
+		return fmt.Errorf("Device %s has no unicast address %s.", device, ip)
+	}
+}
@@ -0,0 +1,204 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipCmTrafficGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipCmTrafficGroupCreate,
+		Read:   resourceBigipCmTrafficGroupRead,
+		Update: resourceBigipCmTrafficGroupUpdate,
+		Delete: resourceBigipCmTrafficGroupDelete,
+		Exists: resourceBigipCmTrafficGroupExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the traffic group, e.g. /Common/traffic-group-2",
+				ValidateFunc: validateF5Name,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+
+			"failover_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "How the next active device is selected: ha-order, ha-score (uses the HA group) or load-aware",
+				ValidateFunc: validateStringValue([]string{"ha-order", "ha-score", "load-aware"}),
+			},
+
+			"ha_order": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Devices the traffic group fails over to, in order, e.g. /Common/bigip2.local",
+			},
+
+			"ha_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "HA group scoring the devices when the failover method is ha-score",
+			},
+
+			"ha_load_factor": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Load of the traffic group relative to the other traffic groups when the failover method is load-aware",
+			},
+
+			"auto_failback": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail back to the first device of ha_order once it is available again",
+			},
+
+			"auto_failback_time": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     60,
+				Description: "Seconds to wait before failing back",
+			},
+
+			"mac": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "none",
+				Description: "MAC masquerade address shared by the floating self IPs of the traffic group, or none",
+			},
+		},
+	}
+}
+
+func resourceBigipCmTrafficGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating traffic group " + name)
+
+	r := dataToTrafficGroup(d)
+	r.Name = name
+	err := client.CreateTrafficGroup(r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create traffic group (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipCmTrafficGroupRead(d, meta)
+}
+
+func resourceBigipCmTrafficGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading traffic group " + name)
+
+	group, err := client.GetTrafficGroup(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve traffic group (%s) (%v) ", name, err)
+		return err
+	}
+	if group == nil {
+		log.Printf("[WARN] Traffic group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("description", group.Description)
+	d.Set("failover_method", group.FailoverMethod)
+	if err := d.Set("ha_order", group.HaOrder); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving ha_order to state for traffic group (%s): %s", d.Id(), err)
+	}
+	d.Set("ha_group", group.HaGroup)
+	d.Set("ha_load_factor", group.HaLoadFactor)
+	d.Set("auto_failback", group.AutoFailbackEnabled == "true")
+	d.Set("auto_failback_time", group.AutoFailbackTime)
+	d.Set("mac", group.Mac)
+
+	return nil
+}
+
+func resourceBigipCmTrafficGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if traffic group exists " + name)
+
+	group, err := client.GetTrafficGroup(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve traffic group (%s) (%v) ", name, err)
+		return false, err
+	}
+	if group == nil {
+		log.Printf("[WARN] Traffic group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipCmTrafficGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating traffic group " + name)
+
+	err := client.ModifyTrafficGroup(name, dataToTrafficGroup(d))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update traffic group (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipCmTrafficGroupRead(d, meta)
+}
+
+func resourceBigipCmTrafficGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting traffic group " + name)
+
+	err := client.DeleteTrafficGroup(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete traffic group (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToTrafficGroup(d *schema.ResourceData) *bigip.TrafficGroup {
+	// The BIG-IP removes the HA group with none, it isn't returned afterwards
+	haGroup := d.Get("ha_group").(string)
+	if haGroup == "" {
+		haGroup = "none"
+	}
+	return &bigip.TrafficGroup{
+		Description:         d.Get("description").(string),
+		FailoverMethod:      d.Get("failover_method").(string),
+		HaOrder:             listToStringSlice(d.Get("ha_order").([]interface{})),
+		HaGroup:             haGroup,
+		HaLoadFactor:        d.Get("ha_load_factor").(int),
+		AutoFailbackEnabled: fmt.Sprintf("%t", d.Get("auto_failback").(bool)),
+		AutoFailbackTime:    d.Get("auto_failback_time").(int),
+		Mac:                 d.Get("mac").(string),
+	}
+}
@@ -0,0 +1,95 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_TRAFFIC_GROUP_NAME = "/Common/test-traffic-group"
+
+var TEST_TRAFFIC_GROUP_RESOURCE = `
+resource "bigip_cm_traffic_group" "test-traffic-group" {
+	name               = "` + TEST_TRAFFIC_GROUP_NAME + `"
+	description        = "test"
+	auto_failback      = true
+	auto_failback_time = 120
+	mac                = "02:01:d7:93:35:08"
+}
+`
+
+func TestAccBigipCmTrafficGroup_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTrafficGroupsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_TRAFFIC_GROUP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTrafficGroupExists(TEST_TRAFFIC_GROUP_NAME, true),
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-traffic-group", "auto_failback", "true"),
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-traffic-group", "auto_failback_time", "120"),
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-traffic-group", "mac", "02:01:d7:93:35:08"),
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-traffic-group", "failover_method", "ha-order"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipCmTrafficGroup_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTrafficGroupsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_TRAFFIC_GROUP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTrafficGroupExists(TEST_TRAFFIC_GROUP_NAME, true),
+				),
+				ResourceName:      TEST_TRAFFIC_GROUP_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckTrafficGroupExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		group, err := client.GetTrafficGroup(name)
+		if err != nil {
+			return err
+		}
+		if exists && group == nil {
+			return fmt.Errorf("Traffic group %s was not created.", name)
+		}
+		if !exists && group != nil {
+			return fmt.Errorf("Traffic group %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckTrafficGroupsDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_cm_traffic_group" {
+			continue
+		}
+		if err := testCheckTrafficGroupExists(rs.Primary.ID, false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipCmTrust() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipCmTrustCreate,
+		Read:   resourceBigipCmTrustRead,
+		Delete: resourceBigipCmTrustDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"peer_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Management address of the peer",
+			},
+
+			"peer_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Device name of the peer, e.g. bigip2.local",
+			},
+
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "User name of an administrator of the peer",
+			},
+
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				ForceNew:    true,
+				Description: "Password of the administrator of the peer",
+			},
+
+			"ca_device": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "Add the peer as a certificate signing authority, otherwise as a subordinate non-authority",
+			},
+
+			"failover_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Failover state of the peer, e.g. standby",
+			},
+		},
+	}
+}
+
+func resourceBigipCmTrustCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("peer_name").(string)
+	log.Println("[INFO] Adding to trust " + name)
+
+	err := client.AddToTrust(
+		d.Get("peer_address").(string),
+		name,
+		d.Get("username").(string),
+		d.Get("password").(string),
+		d.Get("ca_device").(bool),
+	)
+	if err == nil {
+		err = waitForTrustedDevice(client, name, d.Timeout(schema.TimeoutCreate))
+	}
+	if err != nil {
+		log.Printf("[ERROR] Unable to Add to trust (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipCmTrustRead(d, meta)
+}
+
+func resourceBigipCmTrustRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading trust " + name)
+
+	device, err := trustedDevice(client, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve trust (%s) (%v) ", name, err)
+		return err
+	}
+	if device == nil {
+		log.Printf("[WARN] Trust (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("peer_name", name)
+	d.Set("failover_state", device.FailoverState)
+
+	return nil
+}
+
+func resourceBigipCmTrustDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Removing from trust " + name)
+
+	err := client.RemoveFromTrust(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Remove from trust (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// trustedDevice returns the peer <name> of the trust domain, or nil when the
+// peer isn't trusted.
+func trustedDevice(client *bigip.BigIP, name string) (*bigip.Device, error) {
+	devices, err := client.ListDevices()
+	if err != nil {
+		return nil, err
+	}
+	for _, device := range devices.Devices {
+		if device.Name == strings.TrimPrefix(name, "/Common/") && device.SelfDevice != "true" {
+			return &device, nil
+		}
+	}
+	return nil, nil
+}
+
+// waitForTrustedDevice polls cm/device until the peer joined the trust domain.
+func waitForTrustedDevice(client *bigip.BigIP, name string, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		device, err := trustedDevice(client, name)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if device == nil {
+			return resource.RetryableError(fmt.Errorf("device %s not yet trusted", name))
+		}
+		return nil
+	})
+}
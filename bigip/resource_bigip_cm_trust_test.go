@@ -0,0 +1,76 @@
+package bigip
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The test needs a second BIG-IP that isn't trusted yet
+var TEST_TRUST_PEER_ADDRESS = os.Getenv("TEST_TRUST_PEER_ADDRESS")
+var TEST_TRUST_PEER_NAME = os.Getenv("TEST_TRUST_PEER_NAME")
+
+var TEST_TRUST_RESOURCE = `
+resource "bigip_cm_trust" "test-trust" {
+	peer_address = "` + TEST_TRUST_PEER_ADDRESS + `"
+	peer_name    = "` + TEST_TRUST_PEER_NAME + `"
+	username     = "` + os.Getenv("TEST_TRUST_PEER_USER") + `"
+	password     = "` + os.Getenv("TEST_TRUST_PEER_PASSWORD") + `"
+}
+`
+
+func TestAccBigipCmTrust_create(t *testing.T) {
+	if TEST_TRUST_PEER_ADDRESS == "" || TEST_TRUST_PEER_NAME == "" {
+		t.Skip("TEST_TRUST_PEER_ADDRESS or TEST_TRUST_PEER_NAME not set")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTrustsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_TRUST_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTrustExists(TEST_TRUST_PEER_NAME, true),
+					resource.TestCheckResourceAttrSet("bigip_cm_trust.test-trust", "failover_state"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckTrustExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		device, err := trustedDevice(client, name)
+		if err != nil {
+			return err
+		}
+		if exists && device == nil {
+			return fmt.Errorf("Device %s was not trusted.", name)
+		}
+		if !exists && device != nil {
+			return fmt.Errorf("Device %s is still trusted.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckTrustsDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_cm_trust" {
+			continue
+		}
+		if err := testCheckTrustExists(rs.Primary.ID, false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
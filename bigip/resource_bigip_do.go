@@ -0,0 +1,126 @@
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipDo() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipDoCreate,
+		Read:   resourceBigipDoRead,
+		Update: resourceBigipDoUpdate,
+		Delete: resourceBigipDoDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"do_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Declarative Onboarding declaration in JSON",
+				ValidateFunc:     validateDoJson,
+				DiffSuppressFunc: suppressEquivalentJson,
+			},
+		},
+	}
+}
+
+func resourceBigipDoCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Creating DO declaration")
+
+	id, err := postDoDeclaration(client, d.Get("do_json").(string), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create DO declaration (%v) ", err)
+		return err
+	}
+	d.SetId(id)
+
+	return resourceBigipDoRead(d, meta)
+}
+
+// DO doesn't report the deployed declaration in a comparable form, the
+// declaration is only submitted again when it changes.
+func resourceBigipDoRead(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Reading DO declaration " + d.Id())
+	return nil
+}
+
+func resourceBigipDoUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Updating DO declaration " + d.Id())
+
+	id, err := postDoDeclaration(client, d.Get("do_json").(string), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update DO declaration (%s) (%v) ", d.Id(), err)
+		return err
+	}
+	d.SetId(id)
+
+	return resourceBigipDoRead(d, meta)
+}
+
+// Onboarding can't be undone, the declaration is only removed from the state.
+func resourceBigipDoDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Println("[INFO] Removing DO declaration " + d.Id() + " from state, the BIG-IP configuration is left in place")
+	d.SetId("")
+	return nil
+}
+
+func postDoDeclaration(client *bigip.BigIP, declaration string, timeout time.Duration) (string, error) {
+	task, err := client.PostDoDeclaration(json.RawMessage(declaration))
+	if err != nil {
+		return "", err
+	}
+	return task.ID, waitForDoTask(client, task.ID, timeout)
+}
+
+// waitForDoTask polls the DO task until it completes. The BIG-IP may reboot
+// while onboarding, failed requests are retried after authenticating again.
+func waitForDoTask(client *bigip.BigIP, id string, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		task, err := client.GetDoTask(id)
+		if err != nil {
+			if err := client.RefreshTokenSession(); err != nil {
+				log.Printf("[DEBUG] Unable to authenticate while waiting for DO task %s (%v) ", id, err)
+			}
+			return resource.RetryableError(fmt.Errorf("DO task %s unavailable: %s", id, err))
+		}
+		return doTaskStatus(task)
+	})
+}
+
+func doTaskStatus(task *bigip.DoTask) *resource.RetryError {
+	switch task.Result.Status {
+	case bigip.DoStatusOk:
+		return nil
+	case bigip.DoStatusRunning, "":
+		return resource.RetryableError(fmt.Errorf("DO task %s in progress", task.ID))
+	}
+	msg := task.Result.Message
+	if len(task.Result.Errors) > 0 {
+		msg += ": " + strings.Join(task.Result.Errors, ", ")
+	}
+	return resource.NonRetryableError(fmt.Errorf("DO declaration failed (%s): %s", task.Result.Status, msg))
+}
+
+func validateDoJson(value interface{}, field string) (ws []string, errors []error) {
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(value.(string)), &declaration); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", field, err))
+	}
+	return
+}
@@ -0,0 +1,92 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_DO_RESOURCE = `
+resource "bigip_do" "test-do" {
+	do_json = <<EOF
+{
+	"schemaVersion": "1.0.0",
+	"class": "Device",
+	"async": true,
+	"Common": {
+		"class": "Tenant",
+		"dbvars": {
+			"class": "DbVariables",
+			"ui.advisory.enabled": true,
+			"ui.advisory.color": "green",
+			"ui.advisory.text": "/Common/test-do"
+		}
+	}
+}
+EOF
+}
+`
+
+func TestAccBigipDo_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DO_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDoTaskOk("bigip_do.test-do"),
+				),
+			},
+		},
+	})
+}
+
+func TestDoTaskStatus(t *testing.T) {
+	task := &bigip.DoTask{ID: "1"}
+	task.Result.Status = bigip.DoStatusRunning
+	if err := doTaskStatus(task); err == nil || !err.Retryable {
+		t.Errorf("running task should be retried, got %v", err)
+	}
+
+	task.Result.Status = bigip.DoStatusOk
+	if err := doTaskStatus(task); err != nil {
+		t.Errorf("completed task should succeed, got %v", err)
+	}
+
+	task.Result.Status = bigip.DoStatusError
+	task.Result.Message = "invalid config - rolled back"
+	task.Result.Errors = []string{"hostname is invalid"}
+	err := doTaskStatus(task)
+	if err == nil || err.Retryable {
+		t.Fatalf("failed task should not be retried, got %v", err)
+	}
+	expected := "DO declaration failed (ERROR): invalid config - rolled back: hostname is invalid"
+	if err.Err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Err)
+	}
+}
+
+func testCheckDoTaskOk(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("DO resource %s not found", name)
+		}
+		task, err := client.GetDoTask(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if task.Result.Status != bigip.DoStatusOk {
+			return fmt.Errorf("DO task %s is %s", rs.Primary.ID, task.Result.Status)
+		}
+		return nil
+	}
+}
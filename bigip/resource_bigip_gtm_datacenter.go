@@ -0,0 +1,169 @@
+package bigip
+
+import (
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipGtmDatacenter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmDatacenterCreate,
+		Read:   resourceBigipGtmDatacenterRead,
+		Update: resourceBigipGtmDatacenterUpdate,
+		Delete: resourceBigipGtmDatacenterDelete,
+		Exists: resourceBigipGtmDatacenterExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the datacenter",
+				ValidateFunc: validateF5Name,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description of the datacenter",
+			},
+
+			"contact": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Administrator responsible for the datacenter",
+			},
+
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Location of the datacenter",
+			},
+
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables or disables the datacenter for load balancing",
+			},
+
+			"prober_pool": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prober pool used to monitor the servers of the datacenter",
+			},
+		},
+	}
+}
+
+func resourceBigipGtmDatacenterCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating GTM Datacenter " + name)
+
+	p := dataToGtmDatacenter(name, d)
+	err := client.AddDatacenter(&p)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create GTM Datacenter (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipGtmDatacenterRead(d, meta)
+}
+
+func resourceBigipGtmDatacenterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading GTM Datacenter " + name)
+
+	p, err := client.GetDatacenter(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Datacenter (%s) (%v) ", name, err)
+		return err
+	}
+	if p == nil {
+		log.Printf("[WARN] GTM Datacenter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("description", p.Description)
+	d.Set("contact", p.Contact)
+	d.Set("location", p.Location)
+	d.Set("enabled", !p.Disabled)
+	d.Set("prober_pool", p.Prober_pool)
+
+	return nil
+}
+
+func resourceBigipGtmDatacenterExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if GTM Datacenter exists " + name)
+
+	p, err := client.GetDatacenter(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Datacenter (%s) (%v) ", name, err)
+		return false, err
+	}
+	if p == nil {
+		log.Printf("[WARN] GTM Datacenter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipGtmDatacenterUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating GTM Datacenter " + name)
+
+	p := dataToGtmDatacenter(name, d)
+	err := client.ModifyDatacenter(name, &p)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update GTM Datacenter (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipGtmDatacenterRead(d, meta)
+}
+
+func resourceBigipGtmDatacenterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM Datacenter " + name)
+
+	err := client.DeleteDatacenter(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM Datacenter (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToGtmDatacenter(name string, d *schema.ResourceData) bigip.Datacenter {
+	enabled := d.Get("enabled").(bool)
+	return bigip.Datacenter{
+		Name:        name,
+		Description: d.Get("description").(string),
+		Contact:     d.Get("contact").(string),
+		Location:    d.Get("location").(string),
+		Enabled:     enabled,
+		Disabled:    !enabled,
+		Prober_pool: d.Get("prober_pool").(string),
+	}
+}
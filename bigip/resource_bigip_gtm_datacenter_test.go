@@ -0,0 +1,103 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_GTM_DATACENTER_NAME = fmt.Sprintf("/%s/test-datacenter", TEST_PARTITION)
+
+var TEST_GTM_DATACENTER_RESOURCE = `
+resource "bigip_gtm_datacenter" "test-datacenter" {
+	name = "` + TEST_GTM_DATACENTER_NAME + `"
+	description = "test datacenter"
+	contact = "netops@example.com"
+	location = "Seattle"
+}
+`
+
+func TestAccBigipGtmDatacenter_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmDatacentersDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_DATACENTER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmDatacenterExists(TEST_GTM_DATACENTER_NAME, true),
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-datacenter", "name", TEST_GTM_DATACENTER_NAME),
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-datacenter", "description", "test datacenter"),
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-datacenter", "contact", "netops@example.com"),
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-datacenter", "location", "Seattle"),
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-datacenter", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmDatacenter_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmDatacentersDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_DATACENTER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmDatacenterExists(TEST_GTM_DATACENTER_NAME, true),
+				),
+				ResourceName:      TEST_GTM_DATACENTER_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckGtmDatacenterExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		p, err := client.GetDatacenter(name)
+		if err != nil {
+			return err
+		}
+		if exists && p == nil {
+			return fmt.Errorf("GTM datacenter %s was not created.", name)
+		}
+		if !exists && p != nil {
+			return fmt.Errorf("GTM datacenter %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckGtmDatacentersDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_datacenter" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		p, err := client.GetDatacenter(name)
+		if err != nil {
+			return err
+		}
+		if p != nil {
+			return fmt.Errorf("GTM datacenter %s not destroyed.", name)
+		}
+	}
+	return nil
+}
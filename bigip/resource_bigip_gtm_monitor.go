@@ -0,0 +1,260 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var gtmMonitorParents = []string{"/Common/http", "/Common/https", "/Common/tcp", "/Common/gateway_icmp", "/Common/udp"}
+
+func resourceBigipGtmMonitor() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmMonitorCreate,
+		Read:   resourceBigipGtmMonitorRead,
+		Update: resourceBigipGtmMonitorUpdate,
+		Delete: resourceBigipGtmMonitorDelete,
+		Exists: resourceBigipGtmMonitorExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the GTM monitor",
+				ValidateFunc: validateF5Name,
+			},
+
+			"parent": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringValue(gtmMonitorParents),
+				Description:  "Existing GTM monitor to inherit from. Must be one of /Common/http, /Common/https, /Common/tcp, /Common/gateway_icmp or /Common/udp.",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description of the monitor",
+			},
+
+			"destination": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "*:*",
+				Description: "IP address and port of the resource that is the destination of this monitor",
+			},
+
+			"interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Check interval in seconds",
+			},
+
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     120,
+				Description: "Timeout in seconds",
+			},
+
+			"probe_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     5,
+				Description: "Seconds after which the probe is considered failed",
+			},
+
+			"ignore_down_response": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validateEnabledDisabled,
+			},
+
+			"reverse": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validateEnabledDisabled,
+			},
+
+			"transparent": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validateEnabledDisabled,
+			},
+
+			"send": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Request string to send.",
+				StateFunc: func(s interface{}) string {
+					return strings.Replace(s.(string), "\r\n", "\\r\\n", -1)
+				},
+			},
+
+			"receive": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Expected response string.",
+			},
+		},
+	}
+}
+
+func resourceBigipGtmMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	parent := gtmMonitorParent(d.Get("parent").(string))
+	log.Println("[INFO] Creating GTM Monitor " + name + " :: " + parent)
+
+	m := dataToGtmMonitor(name, d)
+	err := client.AddGtmmonitor(parent, &m)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create GTM Monitor (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipGtmMonitorRead(d, meta)
+}
+
+func resourceBigipGtmMonitorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading GTM Monitor " + name)
+
+	m, parent, err := getGtmMonitor(client, name, d.Get("parent").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Monitor (%s) (%v) ", name, err)
+		return err
+	}
+	if m == nil {
+		log.Printf("[WARN] GTM Monitor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("parent", parent)
+	d.Set("description", m.Description)
+	d.Set("destination", m.Destination)
+	d.Set("interval", m.Interval)
+	d.Set("timeout", m.Timeout)
+	d.Set("probe_timeout", m.Probe_timeout)
+	d.Set("ignore_down_response", m.Ignore_down_response)
+	d.Set("reverse", m.Reverse)
+	d.Set("transparent", m.Transparent)
+	if err := d.Set("send", m.Send); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Send to state for GTM Monitor (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("receive", m.Recv); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Receive to state for GTM Monitor (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBigipGtmMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if GTM Monitor exists " + name)
+
+	m, _, err := getGtmMonitor(client, name, d.Get("parent").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Monitor (%s) (%v) ", name, err)
+		return false, err
+	}
+	if m == nil {
+		log.Printf("[WARN] GTM Monitor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipGtmMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating GTM Monitor " + name)
+
+	m := dataToGtmMonitor(name, d)
+	err := client.ModifyGtmmonitor(name, gtmMonitorParent(d.Get("parent").(string)), &m)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update GTM Monitor (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipGtmMonitorRead(d, meta)
+}
+
+func resourceBigipGtmMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	parent := gtmMonitorParent(d.Get("parent").(string))
+	log.Println("[INFO] Deleting GTM Monitor " + name + " :: " + parent)
+
+	err := client.DeleteGtmmonitor(name, parent)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM Monitor (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToGtmMonitor(name string, d *schema.ResourceData) bigip.Gtmmonitor {
+	return bigip.Gtmmonitor{
+		Name:                 name,
+		Defaults_from:        d.Get("parent").(string),
+		Description:          d.Get("description").(string),
+		Destination:          d.Get("destination").(string),
+		Interval:             d.Get("interval").(int),
+		Timeout:              d.Get("timeout").(int),
+		Probe_timeout:        d.Get("probe_timeout").(int),
+		Ignore_down_response: d.Get("ignore_down_response").(string),
+		Reverse:              d.Get("reverse").(string),
+		Transparent:          d.Get("transparent").(string),
+		Send:                 d.Get("send").(string),
+		Recv:                 d.Get("receive").(string),
+	}
+}
+
+// getGtmMonitor looks the monitor up under its parent type. When the parent is
+// not known yet (e.g. on import) every supported type is searched.
+func getGtmMonitor(client *bigip.BigIP, name, parent string) (*bigip.Gtmmonitor, string, error) {
+	parents := gtmMonitorParents
+	if parent != "" {
+		parents = []string{parent}
+	}
+	for _, p := range parents {
+		m, err := client.GetGtmmonitor(name, gtmMonitorParent(p))
+		if err != nil {
+			return nil, "", err
+		}
+		if m != nil {
+			return m, p, nil
+		}
+	}
+	return nil, "", nil
+}
+
+// gtmMonitorParent converts the parent monitor into the monitor type used in the URI
+func gtmMonitorParent(s string) string {
+	return strings.Replace(strings.TrimPrefix(s, "/Common/"), "_", "-", -1)
+}
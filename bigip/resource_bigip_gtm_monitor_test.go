@@ -0,0 +1,104 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_GTM_MONITOR_NAME = fmt.Sprintf("/%s/test-gtm-monitor", TEST_PARTITION)
+
+var TEST_GTM_MONITOR_RESOURCE = `
+resource "bigip_gtm_monitor" "test-gtm-monitor" {
+	name = "` + TEST_GTM_MONITOR_NAME + `"
+	parent = "/Common/http"
+	interval = 10
+	timeout = 31
+	send = "GET /health\r\n"
+	receive = "200 OK"
+}
+`
+
+func TestAccBigipGtmMonitor_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmMonitorsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_MONITOR_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmMonitorExists(TEST_GTM_MONITOR_NAME, true),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-gtm-monitor", "parent", "/Common/http"),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-gtm-monitor", "interval", "10"),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-gtm-monitor", "timeout", "31"),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-gtm-monitor", "receive", "200 OK"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmMonitor_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmMonitorsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_MONITOR_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmMonitorExists(TEST_GTM_MONITOR_NAME, true),
+				),
+				ResourceName:      TEST_GTM_MONITOR_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckGtmMonitorExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		m, err := client.GetGtmmonitor(name, "http")
+		if err != nil {
+			return err
+		}
+		if exists && m == nil {
+			return fmt.Errorf("GTM monitor %s was not created.", name)
+		}
+		if !exists && m != nil {
+			return fmt.Errorf("GTM monitor %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckGtmMonitorsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_monitor" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		m, err := client.GetGtmmonitor(name, "http")
+		if err != nil {
+			return err
+		}
+		if m != nil {
+			return fmt.Errorf("GTM monitor %s not destroyed.", name)
+		}
+	}
+	return nil
+}
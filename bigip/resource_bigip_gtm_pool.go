@@ -0,0 +1,325 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var gtmPoolTypes = []string{"a", "aaaa", "cname"}
+
+var gtmLoadBalancingModes = []string{"round-robin", "ratio", "topology", "global-availability",
+	"static-persistence", "drop-packet", "fallback-ip", "return-to-dns", "none", "completion-rate",
+	"cpu", "fewest-hops", "kilobytes-per-second", "least-connections", "lowest-round-trip-time",
+	"packet-rate", "quality-of-service", "virtual-server-capacity", "virtual-server-score"}
+
+func resourceBigipGtmPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmPoolCreate,
+		Read:   resourceBigipGtmPoolRead,
+		Update: resourceBigipGtmPoolUpdate,
+		Delete: resourceBigipGtmPoolDelete,
+		Exists: resourceBigipGtmPoolExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the GTM pool",
+				ValidateFunc: validateF5Name,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringValue(gtmPoolTypes),
+				Description:  "Type of the GTM pool: a, aaaa or cname",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description of the pool",
+			},
+
+			"monitor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Health monitors for the pool",
+			},
+
+			"load_balancing_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "round-robin",
+				ValidateFunc: validateStringValue(gtmLoadBalancingModes),
+				Description:  "Preferred load balancing mode of the pool",
+			},
+
+			"alternate_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "round-robin",
+				ValidateFunc: validateStringValue(gtmLoadBalancingModes),
+				Description:  "Load balancing mode used when the preferred mode fails",
+			},
+
+			"fallback_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "return-to-dns",
+				ValidateFunc: validateStringValue(gtmLoadBalancingModes),
+				Description:  "Load balancing mode used when the preferred and alternate modes fail",
+			},
+
+			"fallback_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Address returned when the fallback mode is fallback-ip, a and aaaa pools only",
+			},
+
+			"max_answers_returned": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Maximum number of available virtual servers returned in a response",
+			},
+
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Number of seconds the answer is valid",
+			},
+
+			"verify_member_availability": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				ValidateFunc: validateEnabledDisabled,
+			},
+
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disables the pool for load balancing",
+			},
+
+			"members": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Pool members in order. For a and aaaa pools the name is <server>:<virtual server>, for cname pools the target name",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ratio": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+						"static_target": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "The member is a static name instead of a wide IP, cname pools only",
+						},
+						"disabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipGtmPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	poolType := d.Get("type").(string)
+	log.Println("[INFO] Creating GTM Pool " + name + " :: " + poolType)
+
+	p := dataToGtmPool(name, d)
+	err := client.CreateGtmPool(poolType, &p)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create GTM Pool (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipGtmPoolRead(d, meta)
+}
+
+func resourceBigipGtmPoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading GTM Pool " + name)
+
+	p, poolType, err := getGtmPool(client, name, d.Get("type").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Pool (%s) (%v) ", name, err)
+		return err
+	}
+	if p == nil {
+		log.Printf("[WARN] GTM Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("type", poolType)
+	d.Set("description", p.Description)
+	d.Set("monitor", p.Monitor)
+	d.Set("load_balancing_mode", p.Load_balancing_mode)
+	d.Set("alternate_mode", p.Alternate_mode)
+	d.Set("fallback_mode", p.Fallback_mode)
+	d.Set("fallback_ip", p.Fallback_ip)
+	d.Set("max_answers_returned", p.Max_answers_returned)
+	d.Set("ttl", p.Ttl)
+	d.Set("verify_member_availability", p.Verify_member_available)
+	d.Set("disabled", p.Disabled)
+
+	sort.Slice(p.Members, func(i, j int) bool {
+		return p.Members[i].Member_order < p.Members[j].Member_order
+	})
+	var members []map[string]interface{}
+	for _, m := range p.Members {
+		memberName := m.Name
+		if poolType != "cname" && m.FullPath != "" {
+			memberName = m.FullPath
+		}
+		members = append(members, map[string]interface{}{
+			"name":          memberName,
+			"ratio":         m.Ratio,
+			"static_target": m.Static_target == "yes",
+			"disabled":      m.Disabled,
+		})
+	}
+	if err := d.Set("members", members); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Members to state for GTM Pool (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBigipGtmPoolExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if GTM Pool exists " + name)
+
+	p, _, err := getGtmPool(client, name, d.Get("type").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Pool (%s) (%v) ", name, err)
+		return false, err
+	}
+	if p == nil {
+		log.Printf("[WARN] GTM Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipGtmPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating GTM Pool " + name)
+
+	p := dataToGtmPool(name, d)
+	err := client.ModifyGtmPool(d.Get("type").(string), name, &p)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update GTM Pool (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipGtmPoolRead(d, meta)
+}
+
+func resourceBigipGtmPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM Pool " + name)
+
+	err := client.DeleteGtmPool(d.Get("type").(string), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM Pool (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToGtmPool(name string, d *schema.ResourceData) bigip.GtmPool {
+	var p bigip.GtmPool
+
+	p.Name = name
+	p.Description = d.Get("description").(string)
+	p.Monitor = d.Get("monitor").(string)
+	p.Load_balancing_mode = d.Get("load_balancing_mode").(string)
+	p.Alternate_mode = d.Get("alternate_mode").(string)
+	p.Fallback_mode = d.Get("fallback_mode").(string)
+	if d.Get("type").(string) != "cname" {
+		p.Fallback_ip = d.Get("fallback_ip").(string)
+	}
+	p.Max_answers_returned = d.Get("max_answers_returned").(int)
+	p.Ttl = d.Get("ttl").(int)
+	p.Verify_member_available = d.Get("verify_member_availability").(string)
+	p.Disabled = d.Get("disabled").(bool)
+
+	memberCount := d.Get("members.#").(int)
+	p.Members = make([]bigip.GtmPoolMember, 0, memberCount)
+	for i := 0; i < memberCount; i++ {
+		prefix := fmt.Sprintf("members.%d", i)
+		m := bigip.GtmPoolMember{
+			Name:         d.Get(prefix + ".name").(string),
+			Ratio:        d.Get(prefix + ".ratio").(int),
+			Member_order: i,
+			Disabled:     d.Get(prefix + ".disabled").(bool),
+		}
+		if d.Get(prefix + ".static_target").(bool) {
+			m.Static_target = "yes"
+		}
+		p.Members = append(p.Members, m)
+	}
+
+	return p
+}
+
+// getGtmPool looks the pool up under its type. When the type is not known
+// yet (e.g. on import) every pool type is searched.
+func getGtmPool(client *bigip.BigIP, name, poolType string) (*bigip.GtmPool, string, error) {
+	types := gtmPoolTypes
+	if poolType != "" {
+		types = []string{poolType}
+	}
+	for _, t := range types {
+		p, err := client.GetGtmPool(t, name)
+		if err != nil {
+			return nil, "", err
+		}
+		if p != nil {
+			return p, t, nil
+		}
+	}
+	return nil, "", nil
+}
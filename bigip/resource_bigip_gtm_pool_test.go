@@ -0,0 +1,118 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_GTM_POOL_NAME = fmt.Sprintf("/%s/test-gtm-pool", TEST_PARTITION)
+var TEST_GTM_CNAME_POOL_NAME = fmt.Sprintf("/%s/test-gtm-cname-pool", TEST_PARTITION)
+
+var TEST_GTM_POOL_RESOURCE = TEST_GTM_SERVER_RESOURCE + `
+resource "bigip_gtm_pool" "test-gtm-pool" {
+	name = "` + TEST_GTM_POOL_NAME + `"
+	type = "a"
+	load_balancing_mode = "ratio"
+	members {
+		name = "${bigip_gtm_server.test-gtm-server.name}:test-gtm-vs"
+		ratio = 2
+	}
+}
+
+resource "bigip_gtm_pool" "test-gtm-cname-pool" {
+	name = "` + TEST_GTM_CNAME_POOL_NAME + `"
+	type = "cname"
+	members {
+		name = "app.example.com"
+		static_target = true
+	}
+}
+`
+
+func TestAccBigipGtmPool_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmPoolsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_POOL_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmPoolExists(TEST_GTM_POOL_NAME, "a", true),
+					testCheckGtmPoolExists(TEST_GTM_CNAME_POOL_NAME, "cname", true),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "type", "a"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "load_balancing_mode", "ratio"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "members.#", "1"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-pool", "members.0.ratio", "2"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-cname-pool", "members.0.name", "app.example.com"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-gtm-cname-pool", "members.0.static_target", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmPool_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmPoolsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_POOL_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmPoolExists(TEST_GTM_POOL_NAME, "a", true),
+				),
+				ResourceName:      TEST_GTM_POOL_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckGtmPoolExists(name, poolType string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		p, err := client.GetGtmPool(poolType, name)
+		if err != nil {
+			return err
+		}
+		if exists && p == nil {
+			return fmt.Errorf("GTM pool %s was not created.", name)
+		}
+		if !exists && p != nil {
+			return fmt.Errorf("GTM pool %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckGtmPoolsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_pool" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		p, err := client.GetGtmPool(rs.Primary.Attributes["type"], name)
+		if err != nil {
+			return err
+		}
+		if p != nil {
+			return fmt.Errorf("GTM pool %s not destroyed.", name)
+		}
+	}
+	return nil
+}
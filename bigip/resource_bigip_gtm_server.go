@@ -0,0 +1,301 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipGtmServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmServerCreate,
+		Read:   resourceBigipGtmServerRead,
+		Update: resourceBigipGtmServerUpdate,
+		Delete: resourceBigipGtmServerDelete,
+		Exists: resourceBigipGtmServerExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the GTM server",
+				ValidateFunc: validateF5Name,
+			},
+
+			"datacenter": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Datacenter the server belongs to",
+				ValidateFunc: validateF5Name,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description of the server",
+			},
+
+			"product": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "bigip",
+				Description: "Server type, e.g. bigip, generic-host or redundant-bigip",
+			},
+
+			"monitor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Health monitors for the server",
+			},
+
+			"virtual_server_discovery": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validateStringValue([]string{"disabled", "enabled", "enabled-no-delete"}),
+				Description:  "Discover the virtual servers of the server automatically",
+			},
+
+			"link_discovery": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validateStringValue([]string{"disabled", "enabled", "enabled-no-delete"}),
+				Description:  "Discover the links of the server automatically",
+			},
+
+			"addresses": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "IP address of the server",
+						},
+						"device_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the device the address belongs to",
+						},
+						"translation": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "none",
+							Description: "Public address the server address is translated to",
+						},
+					},
+				},
+			},
+
+			"virtual_servers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Virtual servers of the server, only managed while virtual_server_discovery is disabled",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the virtual server",
+						},
+						"destination": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "IP address and port of the virtual server, e.g. 10.10.10.10:80",
+						},
+						"translation_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "none",
+						},
+						"translation_port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+						"monitor": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipGtmServerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating GTM Server " + name)
+
+	p := dataToGtmServer(name, d)
+	err := client.CreateGtmserver(&p)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create GTM Server (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipGtmServerRead(d, meta)
+}
+
+func resourceBigipGtmServerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading GTM Server " + name)
+
+	p, err := client.GetGtmserver(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Server (%s) (%v) ", name, err)
+		return err
+	}
+	if p == nil {
+		log.Printf("[WARN] GTM Server (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("datacenter", p.Datacenter)
+	d.Set("description", p.Description)
+	d.Set("product", p.Product)
+	d.Set("monitor", p.Monitor)
+	d.Set("virtual_server_discovery", p.Virtual_server_discovery)
+	d.Set("link_discovery", p.Link_discovery)
+
+	var addresses []map[string]interface{}
+	for _, a := range p.Addresses {
+		addresses = append(addresses, map[string]interface{}{
+			"name":        a.Name,
+			"device_name": a.Device_name,
+			"translation": a.Translation,
+		})
+	}
+	if err := d.Set("addresses", addresses); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Addresses to state for GTM Server (%s): %s", d.Id(), err)
+	}
+
+	// Discovered virtual servers are owned by the BIG-IP and not compared
+	// with the configuration
+	if p.Virtual_server_discovery != "disabled" {
+		return nil
+	}
+	virtualServers := make([]map[string]interface{}, 0, len(p.GTMVirtual_Server))
+	for _, vs := range p.GTMVirtual_Server {
+		virtualServers = append(virtualServers, map[string]interface{}{
+			"name":                vs.Name,
+			"destination":         vs.Destination,
+			"translation_address": vs.Translation_address,
+			"translation_port":    vs.Translation_port,
+			"monitor":             vs.Monitor,
+		})
+	}
+	if err := d.Set("virtual_servers", virtualServers); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Virtual Servers to state for GTM Server (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBigipGtmServerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if GTM Server exists " + name)
+
+	p, err := client.GetGtmserver(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Server (%s) (%v) ", name, err)
+		return false, err
+	}
+	if p == nil {
+		log.Printf("[WARN] GTM Server (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipGtmServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating GTM Server " + name)
+
+	p := dataToGtmServer(name, d)
+	err := client.UpdateGtmserver(name, &p)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update GTM Server (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipGtmServerRead(d, meta)
+}
+
+func resourceBigipGtmServerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM Server " + name)
+
+	err := client.DeleteGtmserver(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM Server (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToGtmServer(name string, d *schema.ResourceData) bigip.Server {
+	var p bigip.Server
+
+	p.Name = name
+	p.Datacenter = d.Get("datacenter").(string)
+	p.Description = d.Get("description").(string)
+	p.Product = d.Get("product").(string)
+	p.Monitor = d.Get("monitor").(string)
+	p.Virtual_server_discovery = d.Get("virtual_server_discovery").(string)
+	p.Link_discovery = d.Get("link_discovery").(string)
+
+	addressCount := d.Get("addresses.#").(int)
+	p.Addresses = make([]bigip.ServerAddresses, 0, addressCount)
+	for i := 0; i < addressCount; i++ {
+		prefix := fmt.Sprintf("addresses.%d", i)
+		p.Addresses = append(p.Addresses, bigip.ServerAddresses{
+			Name:        d.Get(prefix + ".name").(string),
+			Device_name: d.Get(prefix + ".device_name").(string),
+			Translation: d.Get(prefix + ".translation").(string),
+		})
+	}
+
+	// Discovered virtual servers are owned by the BIG-IP, sending them back
+	// would pin them in the configuration.
+	if p.Virtual_server_discovery == "disabled" {
+		vsCount := d.Get("virtual_servers.#").(int)
+		p.GTMVirtual_Server = make([]bigip.VSrecord, 0, vsCount)
+		for i := 0; i < vsCount; i++ {
+			prefix := fmt.Sprintf("virtual_servers.%d", i)
+			p.GTMVirtual_Server = append(p.GTMVirtual_Server, bigip.VSrecord{
+				Name:                d.Get(prefix + ".name").(string),
+				Destination:         d.Get(prefix + ".destination").(string),
+				Translation_address: d.Get(prefix + ".translation_address").(string),
+				Translation_port:    d.Get(prefix + ".translation_port").(int),
+				Monitor:             d.Get(prefix + ".monitor").(string),
+			})
+		}
+	}
+
+	return p
+}
@@ -0,0 +1,129 @@
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_GTM_SERVER_NAME = fmt.Sprintf("/%s/test-gtm-server", TEST_PARTITION)
+
+var TEST_GTM_SERVER_RESOURCE = TEST_GTM_DATACENTER_RESOURCE + `
+resource "bigip_gtm_server" "test-gtm-server" {
+	name = "` + TEST_GTM_SERVER_NAME + `"
+	datacenter = "${bigip_gtm_datacenter.test-datacenter.name}"
+	product = "generic-host"
+	addresses {
+		name = "10.10.20.1"
+	}
+	virtual_servers {
+		name = "test-gtm-vs"
+		destination = "10.10.20.1:80"
+	}
+}
+`
+
+func TestAccBigipGtmServer_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmServersDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_SERVER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmServerExists(TEST_GTM_SERVER_NAME, true),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "datacenter", TEST_GTM_DATACENTER_NAME),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "product", "generic-host"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "addresses.0.name", "10.10.20.1"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "virtual_servers.0.name", "test-gtm-vs"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-gtm-server", "virtual_servers.0.destination", "10.10.20.1:80"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmServer_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmServersDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_SERVER_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmServerExists(TEST_GTM_SERVER_NAME, true),
+				),
+				ResourceName:      TEST_GTM_SERVER_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestGtmServerVirtualServersJSON(t *testing.T) {
+	cleared, err := json.Marshal(&bigip.Server{Name: "/Common/server", GTMVirtual_Server: []bigip.VSrecord{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(cleared), `"virtualServers":[]`) {
+		t.Errorf("empty virtual servers must be sent to remove them: %s", cleared)
+	}
+
+	untouched, err := json.Marshal(&bigip.Server{Name: "/Common/server"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(untouched), "virtualServers") {
+		t.Errorf("virtual servers must not be sent when not managed: %s", untouched)
+	}
+}
+
+func testCheckGtmServerExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		p, err := client.GetGtmserver(name)
+		if err != nil {
+			return err
+		}
+		if exists && p == nil {
+			return fmt.Errorf("GTM server %s was not created.", name)
+		}
+		if !exists && p != nil {
+			return fmt.Errorf("GTM server %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckGtmServersDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_server" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		p, err := client.GetGtmserver(name)
+		if err != nil {
+			return err
+		}
+		if p != nil {
+			return fmt.Errorf("GTM server %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,314 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipGtmWideip() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipGtmWideipCreate,
+		Read:   resourceBigipGtmWideipRead,
+		Update: resourceBigipGtmWideipUpdate,
+		Delete: resourceBigipGtmWideipDelete,
+		Exists: resourceBigipGtmWideipExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the wide IP, the fully qualified domain name in its partition, e.g. /Common/www.example.com",
+				ValidateFunc: validateF5Name,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStringValue(gtmPoolTypes),
+				Description:  "Type of the wide IP: a, aaaa or cname",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description of the wide IP",
+			},
+
+			"pool_lb_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "round-robin",
+				ValidateFunc: validateStringValue([]string{"round-robin", "ratio", "topology", "global-availability", "random"}),
+				Description:  "Load balancing decision mode used to select one of the pools",
+			},
+
+			"pools": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Pools of the wide IP in order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateF5Name,
+						},
+						"ratio": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+					},
+				},
+			},
+
+			"persistence": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validateEnabledDisabled,
+				Description:  "Return the same answer to a local DNS server on subsequent requests",
+			},
+
+			"persist_cidr_ipv4": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     32,
+				Description: "CIDR mask applied to IPv4 LDNS addresses for persistence",
+			},
+
+			"persist_cidr_ipv6": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     128,
+				Description: "CIDR mask applied to IPv6 LDNS addresses for persistence",
+			},
+
+			"ttl_persistence": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3600,
+				Description: "Seconds the persistence entry is kept",
+			},
+
+			"last_resort_pool": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Pool used when all other pools of the wide IP are unavailable, it must be of the same type as the wide IP",
+			},
+
+			"aliases": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Alternate domain names of the wide IP",
+			},
+
+			"irules": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "GTM iRules of the wide IP in order",
+			},
+
+			"disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disables the wide IP",
+			},
+		},
+	}
+}
+
+func resourceBigipGtmWideipCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	wideipType := d.Get("type").(string)
+	log.Println("[INFO] Creating GTM Wide IP " + name + " :: " + wideipType)
+
+	p := dataToGtmWideip(name, d)
+	err := client.CreateGtmWideip(wideipType, &p)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create GTM Wide IP (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipGtmWideipRead(d, meta)
+}
+
+func resourceBigipGtmWideipRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading GTM Wide IP " + name)
+
+	p, wideipType, err := getGtmWideip(client, name, d.Get("type").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Wide IP (%s) (%v) ", name, err)
+		return err
+	}
+	if p == nil {
+		log.Printf("[WARN] GTM Wide IP (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("type", wideipType)
+	d.Set("description", p.Description)
+	d.Set("pool_lb_mode", p.Pool_lb_mode)
+	d.Set("persistence", p.Persistence)
+	d.Set("persist_cidr_ipv4", p.Persist_cidr_ipv4)
+	d.Set("persist_cidr_ipv6", p.Persist_cidr_ipv6)
+	d.Set("ttl_persistence", p.Ttl_persistence)
+	d.Set("disabled", p.Disabled)
+
+	// The last resort pool is returned as "<type> <pool>"
+	lastResortPool := strings.TrimPrefix(p.Last_resort_pool, wideipType+" ")
+	if lastResortPool == "none" {
+		lastResortPool = ""
+	}
+	d.Set("last_resort_pool", lastResortPool)
+
+	sort.Slice(p.Pools, func(i, j int) bool {
+		return p.Pools[i].Order < p.Pools[j].Order
+	})
+	var pools []map[string]interface{}
+	for _, pool := range p.Pools {
+		pools = append(pools, map[string]interface{}{
+			"name":  fmt.Sprintf("/%s/%s", pool.Partition, pool.Name),
+			"ratio": pool.Ratio,
+		})
+	}
+	if err := d.Set("pools", pools); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Pools to state for GTM Wide IP (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("aliases", p.Aliases); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Aliases to state for GTM Wide IP (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("irules", p.Rules); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving iRules to state for GTM Wide IP (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBigipGtmWideipExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if GTM Wide IP exists " + name)
+
+	p, _, err := getGtmWideip(client, name, d.Get("type").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Wide IP (%s) (%v) ", name, err)
+		return false, err
+	}
+	if p == nil {
+		log.Printf("[WARN] GTM Wide IP (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipGtmWideipUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating GTM Wide IP " + name)
+
+	p := dataToGtmWideip(name, d)
+	err := client.ModifyGtmWideip(d.Get("type").(string), name, &p)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update GTM Wide IP (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipGtmWideipRead(d, meta)
+}
+
+func resourceBigipGtmWideipDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM Wide IP " + name)
+
+	err := client.DeleteGtmWideip(d.Get("type").(string), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM Wide IP (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToGtmWideip(name string, d *schema.ResourceData) bigip.GtmWideip {
+	var p bigip.GtmWideip
+
+	p.Name = name
+	p.Description = d.Get("description").(string)
+	p.Pool_lb_mode = d.Get("pool_lb_mode").(string)
+	p.Persistence = d.Get("persistence").(string)
+	p.Persist_cidr_ipv4 = d.Get("persist_cidr_ipv4").(int)
+	p.Persist_cidr_ipv6 = d.Get("persist_cidr_ipv6").(int)
+	p.Ttl_persistence = d.Get("ttl_persistence").(int)
+	p.Disabled = d.Get("disabled").(bool)
+	p.Enabled = !p.Disabled
+	p.Aliases = setToStringSlice(d.Get("aliases").(*schema.Set))
+	p.Rules = listToStringSlice(d.Get("irules").([]interface{}))
+
+	p.Last_resort_pool = "none"
+	if pool := d.Get("last_resort_pool").(string); pool != "" {
+		p.Last_resort_pool = d.Get("type").(string) + " " + pool
+	}
+
+	poolCount := d.Get("pools.#").(int)
+	p.Pools = make([]bigip.GtmWideipPool, 0, poolCount)
+	for i := 0; i < poolCount; i++ {
+		prefix := fmt.Sprintf("pools.%d", i)
+		partition, poolName := parseF5Identifier(d.Get(prefix + ".name").(string))
+		p.Pools = append(p.Pools, bigip.GtmWideipPool{
+			Name:      poolName,
+			Partition: partition,
+			Order:     i,
+			Ratio:     d.Get(prefix + ".ratio").(int),
+		})
+	}
+
+	return p
+}
+
+// getGtmWideip looks the wide IP up under its type. When the type is not known
+// yet (e.g. on import) every wide IP type is searched.
+func getGtmWideip(client *bigip.BigIP, name, wideipType string) (*bigip.GtmWideip, string, error) {
+	types := gtmPoolTypes
+	if wideipType != "" {
+		types = []string{wideipType}
+	}
+	for _, t := range types {
+		p, err := client.GetGtmWideip(t, name)
+		if err != nil {
+			return nil, "", err
+		}
+		if p != nil {
+			return p, t, nil
+		}
+	}
+	return nil, "", nil
+}
@@ -0,0 +1,113 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_GTM_WIDEIP_NAME = fmt.Sprintf("/%s/test.example.com", TEST_PARTITION)
+
+var TEST_GTM_WIDEIP_RESOURCE = TEST_GTM_POOL_RESOURCE + `
+resource "bigip_gtm_wideip" "test-wideip" {
+	name = "` + TEST_GTM_WIDEIP_NAME + `"
+	type = "a"
+	pool_lb_mode = "ratio"
+	pools {
+		name = "${bigip_gtm_pool.test-gtm-pool.name}"
+		ratio = 3
+	}
+	persistence = "enabled"
+	persist_cidr_ipv4 = 24
+	last_resort_pool = "${bigip_gtm_pool.test-gtm-pool.name}"
+	aliases = ["test-alias.example.com"]
+}
+`
+
+func TestAccBigipGtmWideip_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmWideipsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_WIDEIP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmWideipExists(TEST_GTM_WIDEIP_NAME, true),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "type", "a"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "pool_lb_mode", "ratio"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "pools.0.name", TEST_GTM_POOL_NAME),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "pools.0.ratio", "3"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "persistence", "enabled"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "persist_cidr_ipv4", "24"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "last_resort_pool", TEST_GTM_POOL_NAME),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "aliases.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmWideip_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmWideipsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GTM_WIDEIP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmWideipExists(TEST_GTM_WIDEIP_NAME, true),
+				),
+				ResourceName:      TEST_GTM_WIDEIP_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckGtmWideipExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		p, err := client.GetGtmWideip("a", name)
+		if err != nil {
+			return err
+		}
+		if exists && p == nil {
+			return fmt.Errorf("GTM wide IP %s was not created.", name)
+		}
+		if !exists && p != nil {
+			return fmt.Errorf("GTM wide IP %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckGtmWideipsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_wideip" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		p, err := client.GetGtmWideip(rs.Primary.Attributes["type"], name)
+		if err != nil {
+			return err
+		}
+		if p != nil {
+			return fmt.Errorf("GTM wide IP %s not destroyed.", name)
+		}
+	}
+	return nil
+}
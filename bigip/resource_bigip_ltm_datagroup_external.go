@@ -0,0 +1,126 @@
+package bigip
+
+import (
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipLtmDatagroupExternal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmDatagroupExternalCreate,
+		Read:   resourceBigipLtmDatagroupExternalRead,
+		Update: resourceBigipLtmDatagroupExternalUpdate,
+		Delete: resourceBigipLtmDatagroupExternalDelete,
+		Exists: resourceBigipLtmDatagroupExternalExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the data group, e.g. /Common/blocklist",
+				ValidateFunc: validateF5Name,
+			},
+
+			"external_file_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The data group file holding the records, e.g. /Common/blocklist.txt",
+				ValidateFunc: validateF5Name,
+			},
+		},
+	}
+}
+
+func resourceBigipLtmDatagroupExternalCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating external data group " + name)
+
+	err := client.CreateExternalDataGroup(name, d.Get("external_file_name").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create external data group (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipLtmDatagroupExternalRead(d, meta)
+}
+
+func resourceBigipLtmDatagroupExternalRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading external data group " + name)
+
+	datagroup, err := client.GetExternalDataGroup(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve external data group (%s) (%v) ", name, err)
+		return err
+	}
+	if datagroup == nil {
+		log.Printf("[WARN] External data group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("external_file_name", datagroup.ExternalFileName)
+
+	return nil
+}
+
+func resourceBigipLtmDatagroupExternalExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if external data group exists " + name)
+
+	datagroup, err := client.GetExternalDataGroup(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve external data group (%s) (%v) ", name, err)
+		return false, err
+	}
+	if datagroup == nil {
+		log.Printf("[WARN] External data group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipLtmDatagroupExternalUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating external data group " + name)
+
+	err := client.ModifyExternalDataGroup(name, d.Get("external_file_name").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update external data group (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipLtmDatagroupExternalRead(d, meta)
+}
+
+func resourceBigipLtmDatagroupExternalDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting external data group " + name)
+
+	err := client.DeleteExternalDataGroup(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete external data group (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,89 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_DATAGROUP_EXTERNAL_NAME = "/" + TEST_PARTITION + "/test-blocklist"
+
+var TEST_DATAGROUP_EXTERNAL_RESOURCE = TEST_DATAGROUP_FILE_RESOURCE + `
+resource "bigip_ltm_datagroup_external" "test-blocklist" {
+	name               = "` + TEST_DATAGROUP_EXTERNAL_NAME + `"
+	external_file_name = "${bigip_sys_file_datagroup.test-blocklist.name}"
+}
+`
+
+func TestAccBigipLtmDatagroupExternal_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckLtmDatagroupExternalsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DATAGROUP_EXTERNAL_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckLtmDatagroupExternalExists(TEST_DATAGROUP_EXTERNAL_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_datagroup_external.test-blocklist", "external_file_name", TEST_DATAGROUP_FILE_NAME),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmDatagroupExternal_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckLtmDatagroupExternalsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DATAGROUP_EXTERNAL_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckLtmDatagroupExternalExists(TEST_DATAGROUP_EXTERNAL_NAME, true),
+				),
+				ResourceName:      TEST_DATAGROUP_EXTERNAL_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckLtmDatagroupExternalExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		datagroup, err := client.GetExternalDataGroup(name)
+		if err != nil {
+			return err
+		}
+		if exists && datagroup == nil {
+			return fmt.Errorf("external data group %s was not created.", name)
+		}
+		if !exists && datagroup != nil {
+			return fmt.Errorf("external data group %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckLtmDatagroupExternalsDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_datagroup_external" {
+			continue
+		}
+		if err := testCheckLtmDatagroupExternalExists(rs.Primary.ID, false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
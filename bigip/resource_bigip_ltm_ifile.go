@@ -0,0 +1,126 @@
+package bigip
+
+import (
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipLtmIfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipLtmIfileCreate,
+		Read:   resourceBigipLtmIfileRead,
+		Update: resourceBigipLtmIfileUpdate,
+		Delete: resourceBigipLtmIfileDelete,
+		Exists: resourceBigipLtmIfileExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the iFile used by iRules, e.g. /Common/maintenance",
+				ValidateFunc: validateF5Name,
+			},
+
+			"file_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The sys iFile, e.g. /Common/maintenance.html",
+				ValidateFunc: validateF5Name,
+			},
+		},
+	}
+}
+
+func resourceBigipLtmIfileCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating LTM iFile " + name)
+
+	err := client.CreateLtmIFile(name, d.Get("file_name").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create LTM iFile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipLtmIfileRead(d, meta)
+}
+
+func resourceBigipLtmIfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading LTM iFile " + name)
+
+	ifile, err := client.GetLtmIFile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve LTM iFile (%s) (%v) ", name, err)
+		return err
+	}
+	if ifile == nil {
+		log.Printf("[WARN] LTM iFile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("file_name", ifile.FileName)
+
+	return nil
+}
+
+func resourceBigipLtmIfileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if LTM iFile exists " + name)
+
+	ifile, err := client.GetLtmIFile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve LTM iFile (%s) (%v) ", name, err)
+		return false, err
+	}
+	if ifile == nil {
+		log.Printf("[WARN] LTM iFile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipLtmIfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating LTM iFile " + name)
+
+	err := client.ModifyLtmIFile(name, d.Get("file_name").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update LTM iFile (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipLtmIfileRead(d, meta)
+}
+
+func resourceBigipLtmIfileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting LTM iFile " + name)
+
+	err := client.DeleteLtmIFile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete LTM iFile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,89 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_LTM_IFILE_NAME = "/" + TEST_PARTITION + "/test-ifile"
+
+var TEST_LTM_IFILE_RESOURCE = TEST_SYS_IFILE_RESOURCE + `
+resource "bigip_ltm_ifile" "test-ifile" {
+	name      = "` + TEST_LTM_IFILE_NAME + `"
+	file_name = "${bigip_sys_file_ifile.test-ifile.name}"
+}
+`
+
+func TestAccBigipLtmIfile_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckLtmIfilesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_LTM_IFILE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckLtmIfileExists(TEST_LTM_IFILE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ltm_ifile.test-ifile", "file_name", TEST_SYS_IFILE_NAME),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmIfile_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckLtmIfilesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_LTM_IFILE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckLtmIfileExists(TEST_LTM_IFILE_NAME, true),
+				),
+				ResourceName:      TEST_LTM_IFILE_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckLtmIfileExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		ifile, err := client.GetLtmIFile(name)
+		if err != nil {
+			return err
+		}
+		if exists && ifile == nil {
+			return fmt.Errorf("LTM iFile %s was not created.", name)
+		}
+		if !exists && ifile != nil {
+			return fmt.Errorf("LTM iFile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckLtmIfilesDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_ifile" {
+			continue
+		}
+		if err := testCheckLtmIfileExists(rs.Primary.ID, false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
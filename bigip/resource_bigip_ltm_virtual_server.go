@@ -133,6 +133,12 @@ func resourceBigipLtmVirtualServer() *schema.Resource {
 				Optional: true,
 			},
 
+			"fw_enforced_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "AFM firewall policy enforced on the virtual server",
+			},
+
 			"vlans": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -250,6 +256,7 @@ func resourceBigipLtmVirtualServerRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[DEBUG] Error saving Policies to state for Virtual Server  (%s): %s", d.Id(), err)
 	}
 	d.Set("vlans", vs.Vlans)
+	d.Set("fw_enforced_policy", flattenFwEnforcedPolicy(vs.FwEnforcedPolicy))
 	if err := d.Set("translate_address", vs.TranslateAddress); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving TranslateAddress to state for Virtual Server  (%s): %s", d.Id(), err)
 	}
@@ -381,6 +388,7 @@ func resourceBigipLtmVirtualServerUpdate(d *schema.ResourceData, meta interface{
 		TranslatePort:    d.Get("translate_port").(string),
 		TranslateAddress: d.Get("translate_address").(string),
 		VlansEnabled:     d.Get("vlans_enabled").(bool),
+		FwEnforcedPolicy: expandFwEnforcedPolicy(d),
 	}
 
 	err := client.ModifyVirtualServer(name, vs)
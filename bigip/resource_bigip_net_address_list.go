@@ -0,0 +1,174 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipNetAddressList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetAddressListCreate,
+		Read:   resourceBigipNetAddressListRead,
+		Update: resourceBigipNetAddressListUpdate,
+		Delete: resourceBigipNetAddressListDelete,
+		Exists: resourceBigipNetAddressListExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the address list, e.g. /Common/web-servers",
+				ValidateFunc: validateF5Name,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+
+			"addresses": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Addresses, networks or ranges, e.g. 10.1.10.0/24 or 10.1.10.5-10.1.10.9",
+			},
+
+			"fqdns": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Fully qualified domain names resolved by the BIG-IP",
+			},
+		},
+	}
+}
+
+func resourceBigipNetAddressListCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating address list " + name)
+
+	r := dataToFirewallAddressList(d)
+	r.Name = name
+	err := client.CreateFirewallAddressList(r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create address list (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipNetAddressListRead(d, meta)
+}
+
+func resourceBigipNetAddressListRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading address list " + name)
+
+	list, err := client.GetFirewallAddressList(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve address list (%s) (%v) ", name, err)
+		return err
+	}
+	if list == nil {
+		log.Printf("[WARN] Address list (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("description", list.Description)
+	if err := d.Set("addresses", flattenFirewallEntries(list.Addresses)); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving addresses to state for address list (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("fqdns", flattenFirewallEntries(list.Fqdns)); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving fqdns to state for address list (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBigipNetAddressListExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if address list exists " + name)
+
+	list, err := client.GetFirewallAddressList(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve address list (%s) (%v) ", name, err)
+		return false, err
+	}
+	if list == nil {
+		log.Printf("[WARN] Address list (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipNetAddressListUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating address list " + name)
+
+	err := client.ModifyFirewallAddressList(name, dataToFirewallAddressList(d))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update address list (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipNetAddressListRead(d, meta)
+}
+
+func resourceBigipNetAddressListDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting address list " + name)
+
+	err := client.DeleteFirewallAddressList(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete address list (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToFirewallAddressList(d *schema.ResourceData) *bigip.FirewallAddressList {
+	return &bigip.FirewallAddressList{
+		Description: d.Get("description").(string),
+		Addresses:   expandFirewallEntries(setToStringSlice(d.Get("addresses").(*schema.Set))),
+		Fqdns:       expandFirewallEntries(setToStringSlice(d.Get("fqdns").(*schema.Set))),
+	}
+}
+
+func expandFirewallEntries(names []string) []bigip.FirewallEntry {
+	var entries []bigip.FirewallEntry
+	for _, name := range names {
+		entries = append(entries, bigip.FirewallEntry{Name: name})
+	}
+	return entries
+}
+
+func flattenFirewallEntries(entries []bigip.FirewallEntry) []string {
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name)
+	}
+	return names
+}
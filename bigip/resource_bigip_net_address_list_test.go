@@ -0,0 +1,99 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_ADDRESS_LIST_NAME = fmt.Sprintf("/%s/test-address-list", TEST_PARTITION)
+
+var TEST_ADDRESS_LIST_RESOURCE = `
+resource "bigip_net_address_list" "test-address-list" {
+	name = "` + TEST_ADDRESS_LIST_NAME + `"
+	description = "web servers"
+	addresses = ["10.1.10.0/24", "10.1.20.5-10.1.20.9"]
+}
+`
+
+func TestAccBigipNetAddressList_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAddressListsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_ADDRESS_LIST_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAddressListExists(TEST_ADDRESS_LIST_NAME, true),
+					resource.TestCheckResourceAttr("bigip_net_address_list.test-address-list", "name", TEST_ADDRESS_LIST_NAME),
+					resource.TestCheckResourceAttr("bigip_net_address_list.test-address-list", "description", "web servers"),
+					resource.TestCheckResourceAttr("bigip_net_address_list.test-address-list", "addresses.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetAddressList_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAddressListsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_ADDRESS_LIST_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAddressListExists(TEST_ADDRESS_LIST_NAME, true),
+				),
+				ResourceName:      TEST_ADDRESS_LIST_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAddressListExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		list, err := client.GetFirewallAddressList(name)
+		if err != nil {
+			return err
+		}
+		if exists && list == nil {
+			return fmt.Errorf("address list %s was not created.", name)
+		}
+		if !exists && list != nil {
+			return fmt.Errorf("address list %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckAddressListsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_address_list" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		list, err := client.GetFirewallAddressList(name)
+		if err != nil {
+			return err
+		}
+		if list != nil {
+			return fmt.Errorf("address list %s not destroyed.", name)
+		}
+	}
+	return nil
+}
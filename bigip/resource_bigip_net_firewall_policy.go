@@ -0,0 +1,381 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipNetFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetFirewallPolicyCreate,
+		Read:   resourceBigipNetFirewallPolicyRead,
+		Update: resourceBigipNetFirewallPolicyUpdate,
+		Delete: resourceBigipNetFirewallPolicyDelete,
+		Exists: resourceBigipNetFirewallPolicyExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the firewall policy, e.g. /Common/web-policy",
+				ValidateFunc: validateF5Name,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules of the policy, evaluated in the listed order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the rule, unique within the policy",
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Action for matching traffic: accept, accept-decisively, drop or reject",
+							ValidateFunc: validateStringValue([]string{"accept", "accept-decisively", "drop", "reject"}),
+						},
+
+						"protocol": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "any",
+							Description: "IP protocol to match, e.g. tcp, udp or any",
+						},
+
+						"log": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Log matching traffic",
+						},
+
+						"schedule": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Schedule during which the rule is active, e.g. /Common/business-hours",
+						},
+
+						"source": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Source of the traffic to match",
+							Elem:        firewallRuleEndpointResource(true),
+						},
+
+						"destination": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Destination of the traffic to match",
+							Elem:        firewallRuleEndpointResource(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// firewallRuleEndpointResource returns the schema of a rule source or
+// destination, only sources match on VLANs.
+func firewallRuleEndpointResource(vlans bool) *schema.Resource {
+	set := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeSet,
+			Set:         schema.HashString,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: description,
+		}
+	}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"addresses":     set("Addresses, networks or ranges"),
+			"address_lists": set("Address lists, e.g. /Common/web-servers"),
+			"ports":         set("Ports or port ranges"),
+			"port_lists":    set("Port lists, e.g. /Common/web-ports"),
+		},
+	}
+	if vlans {
+		r.Schema["vlans"] = set("VLANs the traffic arrives on, e.g. /Common/external")
+	}
+	return r
+}
+
+func resourceBigipNetFirewallPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating firewall policy " + name)
+
+	err := client.CreateFirewallPolicy(&bigip.FirewallPolicy{
+		Name:        name,
+		Description: d.Get("description").(string),
+	})
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create firewall policy (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	err = reconcileFirewallPolicyRules(client, name, nil, expandFirewallRules(d.Get("rule").([]interface{})))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create rules of firewall policy (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipNetFirewallPolicyRead(d, meta)
+}
+
+func resourceBigipNetFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading firewall policy " + name)
+
+	policy, err := client.GetFirewallPolicy(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve firewall policy (%s) (%v) ", name, err)
+		return err
+	}
+	if policy == nil {
+		log.Printf("[WARN] Firewall policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	rules, err := client.FirewallPolicyRules(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve rules of firewall policy (%s) (%v) ", name, err)
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("description", policy.Description)
+	if err := d.Set("rule", flattenFirewallRules(rules.Rules)); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving rules to state for firewall policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBigipNetFirewallPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if firewall policy exists " + name)
+
+	policy, err := client.GetFirewallPolicy(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve firewall policy (%s) (%v) ", name, err)
+		return false, err
+	}
+	if policy == nil {
+		log.Printf("[WARN] Firewall policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipNetFirewallPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating firewall policy " + name)
+
+	if d.HasChange("description") {
+		err := client.ModifyFirewallPolicy(name, &bigip.FirewallPolicy{
+			Description: d.Get("description").(string),
+		})
+		if err != nil {
+			log.Printf("[ERROR] Unable to Update firewall policy (%s) (%v) ", name, err)
+			return err
+		}
+	}
+
+	if d.HasChange("rule") {
+		rules, err := client.FirewallPolicyRules(name)
+		if err == nil {
+			err = reconcileFirewallPolicyRules(client, name, rules.Rules, expandFirewallRules(d.Get("rule").([]interface{})))
+		}
+		if err != nil {
+			log.Printf("[ERROR] Unable to Update rules of firewall policy (%s) (%v) ", name, err)
+			return err
+		}
+	}
+
+	return resourceBigipNetFirewallPolicyRead(d, meta)
+}
+
+func resourceBigipNetFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting firewall policy " + name)
+
+	err := client.DeleteFirewallPolicy(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete firewall policy (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// reconcileFirewallPolicyRules brings the rules of the policy in the configured
+// order. Every rule is placed first or after its predecessor, existing rules
+// are modified in place and rules which are not configured anymore are removed.
+func reconcileFirewallPolicyRules(client *bigip.BigIP, name string, current, rules []bigip.FirewallRule) error {
+	existing := make(map[string]bool)
+	for _, r := range current {
+		existing[r.Name] = true
+	}
+
+	placeFirewallRules(rules)
+	configured := make(map[string]bool)
+	for i := range rules {
+		rule := &rules[i]
+		configured[rule.Name] = true
+
+		var err error
+		if existing[rule.Name] {
+			err = client.ModifyFirewallPolicyRule(name, rule.Name, rule)
+		} else {
+			err = client.AddFirewallPolicyRule(name, rule)
+		}
+		if err != nil {
+			return fmt.Errorf("rule %s: %s", rule.Name, err)
+		}
+	}
+
+	for _, r := range current {
+		if configured[r.Name] {
+			continue
+		}
+		if err := client.DeleteFirewallPolicyRule(name, r.Name); err != nil {
+			return fmt.Errorf("rule %s: %s", r.Name, err)
+		}
+	}
+	return nil
+}
+
+// placeFirewallRules sets the placement of each rule so that the BIG-IP keeps
+// the rules in the given order.
+func placeFirewallRules(rules []bigip.FirewallRule) {
+	for i := range rules {
+		if i == 0 {
+			rules[i].PlaceBefore = "first"
+			rules[i].PlaceAfter = ""
+		} else {
+			rules[i].PlaceBefore = ""
+			rules[i].PlaceAfter = rules[i-1].Name
+		}
+	}
+}
+
+func expandFirewallRules(l []interface{}) []bigip.FirewallRule {
+	rules := make([]bigip.FirewallRule, 0, len(l))
+	for _, item := range l {
+		m := item.(map[string]interface{})
+		rule := bigip.FirewallRule{
+			Name:        m["name"].(string),
+			Description: m["description"].(string),
+			Action:      m["action"].(string),
+			IpProtocol:  m["protocol"].(string),
+			Log:         "no",
+			Schedule:    m["schedule"].(string),
+			Source:      expandFirewallRuleEndpoint(m["source"].([]interface{})),
+			Destination: expandFirewallRuleEndpoint(m["destination"].([]interface{})),
+		}
+		if m["log"].(bool) {
+			rule.Log = "yes"
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func expandFirewallRuleEndpoint(l []interface{}) bigip.FirewallRuleEndpoint {
+	var e bigip.FirewallRuleEndpoint
+	if len(l) == 0 || l[0] == nil {
+		return e
+	}
+	m := l[0].(map[string]interface{})
+	e.Addresses = expandFirewallEntries(setToStringSlice(m["addresses"].(*schema.Set)))
+	e.AddressLists = setToStringSlice(m["address_lists"].(*schema.Set))
+	e.Ports = expandFirewallEntries(setToStringSlice(m["ports"].(*schema.Set)))
+	e.PortLists = setToStringSlice(m["port_lists"].(*schema.Set))
+	if vlans, ok := m["vlans"]; ok {
+		e.Vlans = setToStringSlice(vlans.(*schema.Set))
+	}
+	return e
+}
+
+func flattenFirewallRules(rules []bigip.FirewallRule) []interface{} {
+	l := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		protocol := rule.IpProtocol
+		if protocol == "" {
+			protocol = "any"
+		}
+		l = append(l, map[string]interface{}{
+			"name":        rule.Name,
+			"description": rule.Description,
+			"action":      rule.Action,
+			"protocol":    protocol,
+			"log":         rule.Log == "yes",
+			"schedule":    rule.Schedule,
+			"source":      flattenFirewallRuleEndpoint(rule.Source, true),
+			"destination": flattenFirewallRuleEndpoint(rule.Destination, false),
+		})
+	}
+	return l
+}
+
+// An endpoint without any match is not kept, it matches all traffic like an
+// omitted source or destination.
+func flattenFirewallRuleEndpoint(e bigip.FirewallRuleEndpoint, vlans bool) []interface{} {
+	if len(e.Addresses) == 0 && len(e.AddressLists) == 0 && len(e.Ports) == 0 && len(e.PortLists) == 0 && len(e.Vlans) == 0 {
+		return []interface{}{}
+	}
+	m := map[string]interface{}{
+		"addresses":     flattenFirewallEntrySet(e.Addresses),
+		"address_lists": makeStringSet(&e.AddressLists),
+		"ports":         flattenFirewallEntrySet(e.Ports),
+		"port_lists":    makeStringSet(&e.PortLists),
+	}
+	if vlans {
+		m["vlans"] = makeStringSet(&e.Vlans)
+	}
+	return []interface{}{m}
+}
+
+func flattenFirewallEntrySet(entries []bigip.FirewallEntry) *schema.Set {
+	names := flattenFirewallEntries(entries)
+	return makeStringSet(&names)
+}
@@ -0,0 +1,173 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const firewallGlobalContext = "global"
+
+// Policies of virtual servers and self IPs are set with their fw_enforced_policy
+// attribute, this resource covers the contexts without an own resource.
+func resourceBigipNetFirewallPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetFirewallPolicyAttachmentCreate,
+		Read:   resourceBigipNetFirewallPolicyAttachmentRead,
+		Update: resourceBigipNetFirewallPolicyAttachmentUpdate,
+		Delete: resourceBigipNetFirewallPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"context": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Context the policy is enforced in: global or route-domain",
+				ValidateFunc: validateStringValue([]string{firewallGlobalContext, "route-domain"}),
+			},
+
+			"route_domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Route domain the policy is enforced in, e.g. /Common/0. Required for the route-domain context",
+				ValidateFunc: validateF5Name,
+			},
+
+			"policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Firewall policy to enforce, e.g. /Common/web-policy",
+				ValidateFunc: validateF5Name,
+			},
+		},
+	}
+}
+
+func resourceBigipNetFirewallPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	id := firewallGlobalContext
+	if d.Get("context").(string) != firewallGlobalContext {
+		id = d.Get("route_domain").(string)
+		if id == "" {
+			return fmt.Errorf("route_domain is required for the route-domain context")
+		}
+	} else if d.Get("route_domain").(string) != "" {
+		return fmt.Errorf("route_domain can't be set for the global context")
+	}
+
+	policy := d.Get("policy").(string)
+	log.Printf("[INFO] Attaching firewall policy (%s) to (%s)", policy, id)
+
+	err := setFirewallEnforcedPolicy(client, id, policy)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Attach firewall policy (%s) (%v) ", policy, err)
+		return err
+	}
+	d.SetId(id)
+
+	return resourceBigipNetFirewallPolicyAttachmentRead(d, meta)
+}
+
+func resourceBigipNetFirewallPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	id := d.Id()
+	log.Println("[INFO] Reading firewall policy attachment " + id)
+
+	var policy string
+	if id == firewallGlobalContext {
+		rules, err := client.GetFirewallGlobalRules()
+		if err != nil {
+			log.Printf("[ERROR] Unable to Retrieve global firewall rules (%v) ", err)
+			return err
+		}
+		policy = rules.EnforcedPolicy
+		d.Set("context", firewallGlobalContext)
+	} else {
+		rd, err := client.GetRouteDomain(id)
+		if err != nil {
+			log.Printf("[ERROR] Unable to Retrieve route domain (%s) (%v) ", id, err)
+			return err
+		}
+		if rd == nil {
+			log.Printf("[WARN] Route domain (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		policy = rd.FwEnforcedPolicy
+		d.Set("context", "route-domain")
+		d.Set("route_domain", id)
+	}
+
+	if policy == "" || policy == bigip.FirewallNoPolicy {
+		log.Printf("[WARN] Firewall policy of (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceBigipNetFirewallPolicyAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	id := d.Id()
+	policy := d.Get("policy").(string)
+	log.Printf("[INFO] Attaching firewall policy (%s) to (%s)", policy, id)
+
+	err := setFirewallEnforcedPolicy(client, id, policy)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Attach firewall policy (%s) (%v) ", policy, err)
+		return err
+	}
+
+	return resourceBigipNetFirewallPolicyAttachmentRead(d, meta)
+}
+
+func resourceBigipNetFirewallPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	id := d.Id()
+	log.Println("[INFO] Detaching firewall policy from " + id)
+
+	err := setFirewallEnforcedPolicy(client, id, bigip.FirewallNoPolicy)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Detach firewall policy from (%s) (%v) ", id, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func setFirewallEnforcedPolicy(client *bigip.BigIP, id, policy string) error {
+	if id == firewallGlobalContext {
+		return client.SetFirewallGlobalPolicy(policy)
+	}
+	return client.SetRouteDomainFirewallPolicy(id, policy)
+}
+
+// expandFwEnforcedPolicy returns the fw_enforced_policy of a virtual server or
+// self IP. The policy is only detached when fw_enforced_policy was removed,
+// otherwise an empty value leaves it out of the request.
+func expandFwEnforcedPolicy(d *schema.ResourceData) string {
+	policy := d.Get("fw_enforced_policy").(string)
+	if policy == "" && d.HasChange("fw_enforced_policy") {
+		return bigip.FirewallNoPolicy
+	}
+	return policy
+}
+
+func flattenFwEnforcedPolicy(policy string) string {
+	if policy == bigip.FirewallNoPolicy {
+		return ""
+	}
+	return policy
+}
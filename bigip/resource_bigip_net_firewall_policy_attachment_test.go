@@ -0,0 +1,78 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_FIREWALL_POLICY_ATTACHMENT_RESOURCE = `
+resource "bigip_net_firewall_policy" "test-global-policy" {
+	name = "/` + TEST_PARTITION + `/test-global-policy"
+
+	rule {
+		name = "allow-all"
+		action = "accept"
+	}
+}
+
+resource "bigip_net_firewall_policy_attachment" "test-global" {
+	context = "global"
+	policy = "${bigip_net_firewall_policy.test-global-policy.name}"
+}
+`
+
+func TestAccBigipNetFirewallPolicyAttachment_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckFirewallPolicyAttachmentsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_FIREWALL_POLICY_ATTACHMENT_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGlobalFirewallPolicy(fmt.Sprintf("/%s/test-global-policy", TEST_PARTITION)),
+					resource.TestCheckResourceAttr("bigip_net_firewall_policy_attachment.test-global", "context", "global"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckGlobalFirewallPolicy(policy string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		rules, err := client.GetFirewallGlobalRules()
+		if err != nil {
+			return err
+		}
+		if rules.EnforcedPolicy != policy {
+			return fmt.Errorf("global firewall policy is %s, expected %s.", rules.EnforcedPolicy, policy)
+		}
+		return nil
+	}
+}
+
+func testCheckFirewallPolicyAttachmentsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_firewall_policy_attachment" || rs.Primary.ID != firewallGlobalContext {
+			continue
+		}
+
+		rules, err := client.GetFirewallGlobalRules()
+		if err != nil {
+			return err
+		}
+		if rules.EnforcedPolicy != "" && rules.EnforcedPolicy != bigip.FirewallNoPolicy {
+			return fmt.Errorf("global firewall policy %s not detached.", rules.EnforcedPolicy)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_FIREWALL_POLICY_NAME = fmt.Sprintf("/%s/test-firewall-policy", TEST_PARTITION)
+
+var TEST_FIREWALL_POLICY_RESOURCE = `
+resource "bigip_net_address_list" "test-fw-servers" {
+	name = "/` + TEST_PARTITION + `/test-fw-servers"
+	addresses = ["10.1.10.0/24"]
+}
+
+resource "bigip_net_port_list" "test-fw-ports" {
+	name = "/` + TEST_PARTITION + `/test-fw-ports"
+	ports = ["80", "443"]
+}
+
+resource "bigip_net_firewall_policy" "test-firewall-policy" {
+	name = "` + TEST_FIREWALL_POLICY_NAME + `"
+	description = "web policy"
+
+	rule {
+		name = "allow-web"
+		action = "accept"
+		protocol = "tcp"
+		log = true
+
+		destination {
+			address_lists = ["${bigip_net_address_list.test-fw-servers.name}"]
+			port_lists = ["${bigip_net_port_list.test-fw-ports.name}"]
+		}
+	}
+
+	rule {
+		name = "deny-all"
+		action = "drop"
+	}
+}
+`
+
+func TestAccBigipNetFirewallPolicy_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckFirewallPoliciesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_FIREWALL_POLICY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFirewallPolicyExists(TEST_FIREWALL_POLICY_NAME, true),
+					resource.TestCheckResourceAttr("bigip_net_firewall_policy.test-firewall-policy", "name", TEST_FIREWALL_POLICY_NAME),
+					resource.TestCheckResourceAttr("bigip_net_firewall_policy.test-firewall-policy", "description", "web policy"),
+					resource.TestCheckResourceAttr("bigip_net_firewall_policy.test-firewall-policy", "rule.#", "2"),
+					resource.TestCheckResourceAttr("bigip_net_firewall_policy.test-firewall-policy", "rule.0.name", "allow-web"),
+					resource.TestCheckResourceAttr("bigip_net_firewall_policy.test-firewall-policy", "rule.0.log", "true"),
+					resource.TestCheckResourceAttr("bigip_net_firewall_policy.test-firewall-policy", "rule.0.destination.0.port_lists.#", "1"),
+					resource.TestCheckResourceAttr("bigip_net_firewall_policy.test-firewall-policy", "rule.1.name", "deny-all"),
+					resource.TestCheckResourceAttr("bigip_net_firewall_policy.test-firewall-policy", "rule.1.protocol", "any"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetFirewallPolicy_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckFirewallPoliciesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_FIREWALL_POLICY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFirewallPolicyExists(TEST_FIREWALL_POLICY_NAME, true),
+				),
+				ResourceName:      TEST_FIREWALL_POLICY_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var TEST_FIREWALL_VS_NAME = fmt.Sprintf("/%s/test-fw-vs", TEST_PARTITION)
+
+func testFirewallVirtualServerResource(policy string) string {
+	return TEST_FIREWALL_POLICY_RESOURCE + `
+resource "bigip_ltm_virtual_server" "test-fw-vs" {
+	name = "` + TEST_FIREWALL_VS_NAME + `"
+	destination = "10.255.255.253"
+	port = 9999
+	ip_protocol = "tcp"
+	fw_enforced_policy = "` + policy + `"
+	depends_on = ["bigip_net_firewall_policy.test-firewall-policy"]
+}
+`
+}
+
+func TestAccBigipNetFirewallPolicy_virtualServer(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckFirewallPoliciesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testFirewallVirtualServerResource(TEST_FIREWALL_POLICY_NAME),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVirtualServerFirewallPolicy(TEST_FIREWALL_VS_NAME, TEST_FIREWALL_POLICY_NAME),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-fw-vs", "fw_enforced_policy", TEST_FIREWALL_POLICY_NAME),
+				),
+			},
+			{
+				Config: testFirewallVirtualServerResource(""),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVirtualServerFirewallPolicy(TEST_FIREWALL_VS_NAME, ""),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-fw-vs", "fw_enforced_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestPlaceFirewallRules(t *testing.T) {
+	rules := []bigip.FirewallRule{
+		{Name: "a", PlaceAfter: "c"},
+		{Name: "b", PlaceBefore: "first"},
+		{Name: "c"},
+	}
+	placeFirewallRules(rules)
+
+	expected := [][2]string{{"first", ""}, {"", "a"}, {"", "b"}}
+	for i, rule := range rules {
+		if rule.PlaceBefore != expected[i][0] || rule.PlaceAfter != expected[i][1] {
+			t.Errorf("rule %s placed before %q after %q, expected before %q after %q",
+				rule.Name, rule.PlaceBefore, rule.PlaceAfter, expected[i][0], expected[i][1])
+		}
+	}
+}
+
+func TestFirewallRulesRoundTrip(t *testing.T) {
+	rules := []bigip.FirewallRule{
+		{
+			Name:       "allow-web",
+			Action:     "accept",
+			IpProtocol: "tcp",
+			Log:        "yes",
+			Source: bigip.FirewallRuleEndpoint{
+				Vlans: []string{"/Common/external"},
+			},
+			Destination: bigip.FirewallRuleEndpoint{
+				Addresses: []bigip.FirewallEntry{{Name: "10.1.10.0/24"}},
+				PortLists: []string{"/Common/web-ports"},
+			},
+		},
+		{
+			Name:   "deny-all",
+			Action: "drop",
+			Log:    "no",
+		},
+	}
+
+	r := resourceBigipNetFirewallPolicy()
+	d := r.TestResourceData()
+	if err := d.Set("rule", flattenFirewallRules(rules)); err != nil {
+		t.Fatal(err)
+	}
+	expanded := expandFirewallRules(d.Get("rule").([]interface{}))
+
+	if len(expanded) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(expanded))
+	}
+	web := expanded[0]
+	if web.Name != "allow-web" || web.IpProtocol != "tcp" || web.Log != "yes" {
+		t.Errorf("unexpected first rule %+v", web)
+	}
+	if len(web.Source.Vlans) != 1 || web.Source.Vlans[0] != "/Common/external" {
+		t.Errorf("unexpected source VLANs %v", web.Source.Vlans)
+	}
+	if len(web.Destination.Addresses) != 1 || web.Destination.Addresses[0].Name != "10.1.10.0/24" {
+		t.Errorf("unexpected destination addresses %v", web.Destination.Addresses)
+	}
+	if len(web.Destination.PortLists) != 1 || web.Destination.PortLists[0] != "/Common/web-ports" {
+		t.Errorf("unexpected destination port lists %v", web.Destination.PortLists)
+	}
+	deny := expanded[1]
+	if deny.Name != "deny-all" || deny.IpProtocol != "any" || deny.Log != "no" {
+		t.Errorf("unexpected second rule %+v", deny)
+	}
+	if d.Get("rule.1.source").([]interface{}) == nil || len(d.Get("rule.1.source").([]interface{})) != 0 {
+		t.Errorf("unexpected source of the second rule %v", d.Get("rule.1.source"))
+	}
+	if _, ok := d.Get("rule.0.destination.0.vlans").(*schema.Set); ok {
+		t.Errorf("destination must not have VLANs")
+	}
+}
+
+func testCheckFirewallPolicyExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		policy, err := client.GetFirewallPolicy(name)
+		if err != nil {
+			return err
+		}
+		if exists && policy == nil {
+			return fmt.Errorf("firewall policy %s was not created.", name)
+		}
+		if !exists && policy != nil {
+			return fmt.Errorf("firewall policy %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckVirtualServerFirewallPolicy(name, policy string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		vs, err := client.GetVirtualServer(name)
+		if err != nil {
+			return err
+		}
+		if vs == nil {
+			return fmt.Errorf("virtual server %s was not created.", name)
+		}
+		if flattenFwEnforcedPolicy(vs.FwEnforcedPolicy) != policy {
+			return fmt.Errorf("virtual server %s enforces firewall policy %q, expected %q.", name, vs.FwEnforcedPolicy, policy)
+		}
+		return nil
+	}
+}
+
+func testCheckFirewallPoliciesDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_firewall_policy" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		policy, err := client.GetFirewallPolicy(name)
+		if err != nil {
+			return err
+		}
+		if policy != nil {
+			return fmt.Errorf("firewall policy %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipNetPortList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipNetPortListCreate,
+		Read:   resourceBigipNetPortListRead,
+		Update: resourceBigipNetPortListUpdate,
+		Delete: resourceBigipNetPortListDelete,
+		Exists: resourceBigipNetPortListExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the port list, e.g. /Common/web-ports",
+				ValidateFunc: validateF5Name,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+
+			"ports": {
+				Type:        schema.TypeSet,
+				Set:         schema.HashString,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Description: "Ports or port ranges, e.g. 443 or 8000-8080",
+			},
+		},
+	}
+}
+
+func resourceBigipNetPortListCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating port list " + name)
+
+	r := dataToFirewallPortList(d)
+	r.Name = name
+	err := client.CreateFirewallPortList(r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create port list (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipNetPortListRead(d, meta)
+}
+
+func resourceBigipNetPortListRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading port list " + name)
+
+	list, err := client.GetFirewallPortList(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve port list (%s) (%v) ", name, err)
+		return err
+	}
+	if list == nil {
+		log.Printf("[WARN] Port list (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("description", list.Description)
+	if err := d.Set("ports", flattenFirewallEntries(list.Ports)); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving ports to state for port list (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBigipNetPortListExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if port list exists " + name)
+
+	list, err := client.GetFirewallPortList(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve port list (%s) (%v) ", name, err)
+		return false, err
+	}
+	if list == nil {
+		log.Printf("[WARN] Port list (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipNetPortListUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating port list " + name)
+
+	err := client.ModifyFirewallPortList(name, dataToFirewallPortList(d))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update port list (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipNetPortListRead(d, meta)
+}
+
+func resourceBigipNetPortListDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting port list " + name)
+
+	err := client.DeleteFirewallPortList(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete port list (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToFirewallPortList(d *schema.ResourceData) *bigip.FirewallPortList {
+	return &bigip.FirewallPortList{
+		Description: d.Get("description").(string),
+		Ports:       expandFirewallEntries(setToStringSlice(d.Get("ports").(*schema.Set))),
+	}
+}
@@ -0,0 +1,99 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_PORT_LIST_NAME = fmt.Sprintf("/%s/test-port-list", TEST_PARTITION)
+
+var TEST_PORT_LIST_RESOURCE = `
+resource "bigip_net_port_list" "test-port-list" {
+	name = "` + TEST_PORT_LIST_NAME + `"
+	description = "web ports"
+	ports = ["443", "8000-8080"]
+}
+`
+
+func TestAccBigipNetPortList_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPortListsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_PORT_LIST_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPortListExists(TEST_PORT_LIST_NAME, true),
+					resource.TestCheckResourceAttr("bigip_net_port_list.test-port-list", "name", TEST_PORT_LIST_NAME),
+					resource.TestCheckResourceAttr("bigip_net_port_list.test-port-list", "description", "web ports"),
+					resource.TestCheckResourceAttr("bigip_net_port_list.test-port-list", "ports.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipNetPortList_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPortListsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_PORT_LIST_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPortListExists(TEST_PORT_LIST_NAME, true),
+				),
+				ResourceName:      TEST_PORT_LIST_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckPortListExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		list, err := client.GetFirewallPortList(name)
+		if err != nil {
+			return err
+		}
+		if exists && list == nil {
+			return fmt.Errorf("port list %s was not created.", name)
+		}
+		if !exists && list != nil {
+			return fmt.Errorf("port list %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckPortListsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_net_port_list" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		list, err := client.GetFirewallPortList(name)
+		if err != nil {
+			return err
+		}
+		if list != nil {
+			return fmt.Errorf("port list %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -45,6 +45,12 @@ func resourceBigipNetSelfIP() *schema.Resource {
 				Description: "Name of the traffic group, defaults to traffic-group-local-only if not specified",
 				Default:     "traffic-group-local-only",
 			},
+
+			"fw_enforced_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "AFM firewall policy enforced on the SelfIP",
+			},
 		},
 	}
 }
@@ -90,6 +96,7 @@ func resourceBigipNetSelfIPRead(d *schema.ResourceData, meta interface{}) error
 	for _, selfip := range selfIPs.SelfIPs {
 		log.Println(selfip.Name)
 		if selfip.Name == name {
+			d.Set("fw_enforced_policy", flattenFwEnforcedPolicy(selfip.FwEnforcedPolicy))
 			return nil
 		}
 	}
@@ -115,10 +122,11 @@ func resourceBigipNetSelfIPUpdate(d *schema.ResourceData, meta interface{}) erro
 	log.Println("[INFO] Updating SelfIP " + name)
 
 	r := &bigip.SelfIP{
-		Name:         name,
-		Address:      d.Get("ip").(string),
-		Vlan:         d.Get("vlan").(string),
-		TrafficGroup: d.Get("traffic_group").(string),
+		Name:             name,
+		Address:          d.Get("ip").(string),
+		Vlan:             d.Get("vlan").(string),
+		TrafficGroup:     d.Get("traffic_group").(string),
+		FwEnforcedPolicy: expandFwEnforcedPolicy(d),
 	}
 
 	err := client.ModifySelfIP(name, r)
@@ -0,0 +1,203 @@
+package bigip
+
+import (
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSecurityBotDefenseProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSecurityBotDefenseProfileCreate,
+		Read:   resourceBigipSecurityBotDefenseProfileRead,
+		Update: resourceBigipSecurityBotDefenseProfileUpdate,
+		Delete: resourceBigipSecurityBotDefenseProfileDelete,
+		Exists: resourceBigipSecurityBotDefenseProfileExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the bot defense profile, e.g. /Common/public-bot-defense",
+				ValidateFunc: validateF5Name,
+			},
+
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "/Common/bot-defense",
+				Description:  "Parent profile",
+				ValidateFunc: validateF5Name,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+
+			"enforcement_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Enforcement mode: transparent or blocking",
+				ValidateFunc: validateStringValue([]string{"transparent", "blocking"}),
+			},
+
+			"template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Template of the mitigation settings: relaxed, balanced or strict",
+				ValidateFunc: validateStringValue([]string{"relaxed", "balanced", "strict"}),
+			},
+
+			"allow_browser_access": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Allow browsers without mitigation: enabled or disabled",
+				ValidateFunc: validateEnabledDisabled,
+			},
+
+			"dos_attack_strict_mitigation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Mitigate more strictly during DoS attacks: enabled or disabled",
+				ValidateFunc: validateEnabledDisabled,
+			},
+
+			"signature_staging_upon_update": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Stage new and updated bot signatures: enabled or disabled",
+				ValidateFunc: validateEnabledDisabled,
+			},
+
+			"cross_domain_requests": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Validation of cross domain requests, e.g. allow-all",
+			},
+		},
+	}
+}
+
+func resourceBigipSecurityBotDefenseProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating bot defense profile " + name)
+
+	r := dataToBotDefenseProfile(d)
+	r.Name = name
+	r.DefaultsFrom = d.Get("defaults_from").(string)
+	err := client.CreateBotDefenseProfile(r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create bot defense profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipSecurityBotDefenseProfileRead(d, meta)
+}
+
+func resourceBigipSecurityBotDefenseProfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading bot defense profile " + name)
+
+	profile, err := client.GetBotDefenseProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve bot defense profile (%s) (%v) ", name, err)
+		return err
+	}
+	if profile == nil {
+		log.Printf("[WARN] Bot defense profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("defaults_from", profile.DefaultsFrom)
+	d.Set("description", profile.Description)
+	d.Set("enforcement_mode", profile.EnforcementMode)
+	d.Set("template", profile.Template)
+	d.Set("allow_browser_access", profile.AllowBrowserAccess)
+	d.Set("dos_attack_strict_mitigation", profile.DosAttackStrictMitigation)
+	d.Set("signature_staging_upon_update", profile.SignatureStagingUponUpdate)
+	d.Set("cross_domain_requests", profile.CrossDomainRequests)
+
+	return nil
+}
+
+func resourceBigipSecurityBotDefenseProfileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if bot defense profile exists " + name)
+
+	profile, err := client.GetBotDefenseProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve bot defense profile (%s) (%v) ", name, err)
+		return false, err
+	}
+	if profile == nil {
+		log.Printf("[WARN] Bot defense profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipSecurityBotDefenseProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating bot defense profile " + name)
+
+	err := client.ModifyBotDefenseProfile(name, dataToBotDefenseProfile(d))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update bot defense profile (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipSecurityBotDefenseProfileRead(d, meta)
+}
+
+func resourceBigipSecurityBotDefenseProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting bot defense profile " + name)
+
+	err := client.DeleteBotDefenseProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete bot defense profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToBotDefenseProfile(d *schema.ResourceData) *bigip.BotDefenseProfile {
+	return &bigip.BotDefenseProfile{
+		Description:                d.Get("description").(string),
+		EnforcementMode:            d.Get("enforcement_mode").(string),
+		Template:                   d.Get("template").(string),
+		AllowBrowserAccess:         d.Get("allow_browser_access").(string),
+		DosAttackStrictMitigation:  d.Get("dos_attack_strict_mitigation").(string),
+		SignatureStagingUponUpdate: d.Get("signature_staging_upon_update").(string),
+		CrossDomainRequests:        d.Get("cross_domain_requests").(string),
+	}
+}
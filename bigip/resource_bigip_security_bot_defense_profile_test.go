@@ -0,0 +1,100 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_BOT_DEFENSE_PROFILE_NAME = fmt.Sprintf("/%s/test-bot-defense", TEST_PARTITION)
+
+var TEST_BOT_DEFENSE_PROFILE_RESOURCE = `
+resource "bigip_security_bot_defense_profile" "test-bot-defense" {
+	name = "` + TEST_BOT_DEFENSE_PROFILE_NAME + `"
+	description = "public VIPs"
+	enforcement_mode = "transparent"
+	template = "balanced"
+}
+`
+
+func TestAccBigipSecurityBotDefenseProfile_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBotDefenseProfilesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_BOT_DEFENSE_PROFILE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBotDefenseProfileExists(TEST_BOT_DEFENSE_PROFILE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_security_bot_defense_profile.test-bot-defense", "defaults_from", "/Common/bot-defense"),
+					resource.TestCheckResourceAttr("bigip_security_bot_defense_profile.test-bot-defense", "enforcement_mode", "transparent"),
+					resource.TestCheckResourceAttr("bigip_security_bot_defense_profile.test-bot-defense", "template", "balanced"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSecurityBotDefenseProfile_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBotDefenseProfilesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_BOT_DEFENSE_PROFILE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBotDefenseProfileExists(TEST_BOT_DEFENSE_PROFILE_NAME, true),
+				),
+				ResourceName:      TEST_BOT_DEFENSE_PROFILE_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBotDefenseProfileExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		profile, err := client.GetBotDefenseProfile(name)
+		if err != nil {
+			return err
+		}
+		if exists && profile == nil {
+			return fmt.Errorf("bot defense profile %s was not created.", name)
+		}
+		if !exists && profile != nil {
+			return fmt.Errorf("bot defense profile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBotDefenseProfilesDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_security_bot_defense_profile" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		profile, err := client.GetBotDefenseProfile(name)
+		if err != nil {
+			return err
+		}
+		if profile != nil {
+			return fmt.Errorf("bot defense profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
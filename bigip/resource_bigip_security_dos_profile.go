@@ -0,0 +1,455 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSecurityDosProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSecurityDosProfileCreate,
+		Read:   resourceBigipSecurityDosProfileRead,
+		Update: resourceBigipSecurityDosProfileUpdate,
+		Delete: resourceBigipSecurityDosProfileDelete,
+		Exists: resourceBigipSecurityDosProfileExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the DoS profile, e.g. /Common/public-dos",
+				ValidateFunc: validateF5Name,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+
+			"application": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Protection of HTTP applications",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tps_based": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Detection by the transactions per second",
+							Elem:        dosApplicationDetectionResource(),
+						},
+
+						"stress_based": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Detection by the stress of the servers",
+							Elem:        dosApplicationDetectionResource(),
+						},
+					},
+				},
+			},
+
+			"network_vector": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Network attack vectors, e.g. tcp-syn-flood",
+				Elem:        dosAttackVectorResource(),
+			},
+
+			"dns_vector": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "DNS attack vectors by query type, e.g. a or any",
+				Elem:        dosAttackVectorResource(),
+			},
+		},
+	}
+}
+
+func dosApplicationDetectionResource() *schema.Resource {
+	threshold := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: description,
+		}
+	}
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "off",
+				Description:  "Operation mode: off, transparent or blocking",
+				ValidateFunc: validateStringValue([]string{"off", "transparent", "blocking"}),
+			},
+			"ip_minimum_tps":         threshold("Minimum TPS of a client IP to be considered an attacker"),
+			"ip_maximum_tps":         threshold("TPS of a client IP that is always considered an attack"),
+			"ip_tps_increase_rate":   threshold("Increase of the TPS of a client IP in percent considered an attack"),
+			"url_minimum_tps":        threshold("Minimum TPS of a URL to be considered under attack"),
+			"url_maximum_tps":        threshold("TPS of a URL that is always considered an attack"),
+			"url_tps_increase_rate":  threshold("Increase of the TPS of a URL in percent considered an attack"),
+			"site_minimum_tps":       threshold("Minimum TPS of the site to be considered under attack"),
+			"site_maximum_tps":       threshold("TPS of the site that is always considered an attack"),
+			"site_tps_increase_rate": threshold("Increase of the TPS of the site in percent considered an attack"),
+		},
+	}
+}
+
+func dosAttackVectorResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Type of the attack vector",
+			},
+
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "mitigate",
+				Description:  "State of the vector: mitigate, detect-only, learn-only or disabled",
+				ValidateFunc: validateStringValue([]string{"mitigate", "detect-only", "learn-only", "disabled"}),
+			},
+
+			"rate_threshold": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Packets per second detected as an attack",
+			},
+
+			"rate_increase": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Increase of the packet rate in percent detected as an attack",
+			},
+
+			"rate_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Packets per second the attack is limited to",
+			},
+		},
+	}
+}
+
+func resourceBigipSecurityDosProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating DoS profile " + name)
+
+	err := client.CreateDosProfile(&bigip.DosProfile{
+		Name:        name,
+		Description: d.Get("description").(string),
+	})
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create DoS profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	err = setDosProfileProtections(client, d)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create protections of DoS profile (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipSecurityDosProfileRead(d, meta)
+}
+
+func resourceBigipSecurityDosProfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading DoS profile " + name)
+
+	profile, err := client.GetDosProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve DoS profile (%s) (%v) ", name, err)
+		return err
+	}
+	if profile == nil {
+		log.Printf("[WARN] DoS profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	app, err := client.GetDosApplication(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve application protection of DoS profile (%s) (%v) ", name, err)
+		return err
+	}
+	network, err := client.GetDosNetwork(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve network protection of DoS profile (%s) (%v) ", name, err)
+		return err
+	}
+	dns, err := client.GetDosDns(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve DNS protection of DoS profile (%s) (%v) ", name, err)
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("description", profile.Description)
+	if err := d.Set("application", flattenDosApplication(app, d.Get("application").([]interface{}))); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving application to state for DoS profile (%s): %s", d.Id(), err)
+	}
+	var networkVectors, dnsVectors []bigip.DosAttackVector
+	if network != nil {
+		networkVectors = network.AttackVectors
+	}
+	if dns != nil {
+		dnsVectors = dns.AttackVectors
+	}
+	if err := d.Set("network_vector", flattenDosAttackVectors(networkVectors, d.Get("network_vector").([]interface{}))); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving network vectors to state for DoS profile (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("dns_vector", flattenDosAttackVectors(dnsVectors, d.Get("dns_vector").([]interface{}))); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving DNS vectors to state for DoS profile (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBigipSecurityDosProfileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if DoS profile exists " + name)
+
+	profile, err := client.GetDosProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve DoS profile (%s) (%v) ", name, err)
+		return false, err
+	}
+	if profile == nil {
+		log.Printf("[WARN] DoS profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipSecurityDosProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating DoS profile " + name)
+
+	if d.HasChange("description") {
+		err := client.ModifyDosProfile(name, &bigip.DosProfile{
+			Description: d.Get("description").(string),
+		})
+		if err != nil {
+			log.Printf("[ERROR] Unable to Update DoS profile (%s) (%v) ", name, err)
+			return err
+		}
+	}
+
+	err := setDosProfileProtections(client, d)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update protections of DoS profile (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipSecurityDosProfileRead(d, meta)
+}
+
+func resourceBigipSecurityDosProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting DoS profile " + name)
+
+	err := client.DeleteDosProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete DoS profile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// setDosProfileProtections creates or replaces the changed protections and
+// removes the protections which are not configured anymore.
+func setDosProfileProtections(client *bigip.BigIP, d *schema.ResourceData) error {
+	name := d.Id()
+	created := d.IsNewResource()
+
+	if created || d.HasChange("application") {
+		var err error
+		if app := expandDosApplication(d.Get("application").([]interface{})); app != nil {
+			err = client.SetDosApplication(name, app)
+		} else if !created {
+			err = client.DeleteDosApplication(name)
+		}
+		if err != nil {
+			return fmt.Errorf("application: %s", err)
+		}
+	}
+
+	if created || d.HasChange("network_vector") {
+		var err error
+		if vectors := expandDosAttackVectors(d.Get("network_vector").([]interface{})); len(vectors) > 0 {
+			err = client.SetDosNetwork(name, &bigip.DosNetwork{AttackVectors: vectors})
+		} else if !created {
+			err = client.DeleteDosNetwork(name)
+		}
+		if err != nil {
+			return fmt.Errorf("network: %s", err)
+		}
+	}
+
+	if created || d.HasChange("dns_vector") {
+		var err error
+		if vectors := expandDosAttackVectors(d.Get("dns_vector").([]interface{})); len(vectors) > 0 {
+			err = client.SetDosDns(name, &bigip.DosDns{AttackVectors: vectors})
+		} else if !created {
+			err = client.DeleteDosDns(name)
+		}
+		if err != nil {
+			return fmt.Errorf("DNS: %s", err)
+		}
+	}
+	return nil
+}
+
+func expandDosApplication(l []interface{}) *bigip.DosApplication {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+	return &bigip.DosApplication{
+		TpsBased:    expandDosApplicationDetection(m["tps_based"].([]interface{})),
+		StressBased: expandDosApplicationDetection(m["stress_based"].([]interface{})),
+	}
+}
+
+// expandDosApplicationDetection turns an unset detection off, so that removing
+// it from the configuration disables it on the BIG-IP.
+func expandDosApplicationDetection(l []interface{}) *bigip.DosApplicationDetection {
+	if len(l) == 0 || l[0] == nil {
+		return &bigip.DosApplicationDetection{Mode: "off"}
+	}
+	m := l[0].(map[string]interface{})
+	return &bigip.DosApplicationDetection{
+		Mode:                m["mode"].(string),
+		IpMinimumTps:        m["ip_minimum_tps"].(int),
+		IpMaximumTps:        m["ip_maximum_tps"].(int),
+		IpTpsIncreaseRate:   m["ip_tps_increase_rate"].(int),
+		UrlMinimumTps:       m["url_minimum_tps"].(int),
+		UrlMaximumTps:       m["url_maximum_tps"].(int),
+		UrlTpsIncreaseRate:  m["url_tps_increase_rate"].(int),
+		SiteMinimumTps:      m["site_minimum_tps"].(int),
+		SiteMaximumTps:      m["site_maximum_tps"].(int),
+		SiteTpsIncreaseRate: m["site_tps_increase_rate"].(int),
+	}
+}
+
+// flattenDosApplication keeps a detection when it is configured or not off.
+// The BIG-IP reports both detections with their defaults, an unconfigured one
+// that is off would otherwise show up as a change.
+func flattenDosApplication(app *bigip.DosApplication, configured []interface{}) []interface{} {
+	if app == nil {
+		return []interface{}{}
+	}
+	m := make(map[string]interface{})
+	if len(configured) > 0 && configured[0] != nil {
+		m = configured[0].(map[string]interface{})
+	}
+	isConfigured := func(key string) bool {
+		l, ok := m[key].([]interface{})
+		return ok && len(l) > 0
+	}
+	return []interface{}{map[string]interface{}{
+		"tps_based":    flattenDosApplicationDetection(app.TpsBased, isConfigured("tps_based")),
+		"stress_based": flattenDosApplicationDetection(app.StressBased, isConfigured("stress_based")),
+	}}
+}
+
+func flattenDosApplicationDetection(detection *bigip.DosApplicationDetection, configured bool) []interface{} {
+	if detection == nil || (!configured && detection.Mode == "off") {
+		return []interface{}{}
+	}
+	return []interface{}{map[string]interface{}{
+		"mode":                   detection.Mode,
+		"ip_minimum_tps":         detection.IpMinimumTps,
+		"ip_maximum_tps":         detection.IpMaximumTps,
+		"ip_tps_increase_rate":   detection.IpTpsIncreaseRate,
+		"url_minimum_tps":        detection.UrlMinimumTps,
+		"url_maximum_tps":        detection.UrlMaximumTps,
+		"url_tps_increase_rate":  detection.UrlTpsIncreaseRate,
+		"site_minimum_tps":       detection.SiteMinimumTps,
+		"site_maximum_tps":       detection.SiteMaximumTps,
+		"site_tps_increase_rate": detection.SiteTpsIncreaseRate,
+	}}
+}
+
+func expandDosAttackVectors(l []interface{}) []bigip.DosAttackVector {
+	vectors := make([]bigip.DosAttackVector, 0, len(l))
+	for _, item := range l {
+		m := item.(map[string]interface{})
+		vectors = append(vectors, bigip.DosAttackVector{
+			Type:          m["type"].(string),
+			State:         m["state"].(string),
+			RateThreshold: m["rate_threshold"].(int),
+			RateIncrease:  m["rate_increase"].(int),
+			RateLimit:     m["rate_limit"].(int),
+		})
+	}
+	return vectors
+}
+
+// flattenDosAttackVectors keeps the configured vectors in the configured
+// order. The BIG-IP reports every vector with its defaults, they are only
+// kept when no vector is configured, e.g. after an import.
+func flattenDosAttackVectors(vectors []bigip.DosAttackVector, configured []interface{}) []interface{} {
+	byType := make(map[string]bigip.DosAttackVector)
+	for _, v := range vectors {
+		byType[v.Type] = v
+	}
+
+	var types []string
+	for _, item := range configured {
+		types = append(types, item.(map[string]interface{})["type"].(string))
+	}
+	if len(types) == 0 {
+		for _, v := range vectors {
+			types = append(types, v.Type)
+		}
+	}
+
+	l := make([]interface{}, 0, len(types))
+	for _, t := range types {
+		v, ok := byType[t]
+		if !ok {
+			continue
+		}
+		l = append(l, map[string]interface{}{
+			"type":           v.Type,
+			"state":          v.State,
+			"rate_threshold": v.RateThreshold,
+			"rate_increase":  v.RateIncrease,
+			"rate_limit":     v.RateLimit,
+		})
+	}
+	return l
+}
@@ -0,0 +1,201 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_DOS_PROFILE_NAME = fmt.Sprintf("/%s/test-dos-profile", TEST_PARTITION)
+
+var TEST_DOS_PROFILE_RESOURCE = `
+resource "bigip_security_dos_profile" "test-dos-profile" {
+	name = "` + TEST_DOS_PROFILE_NAME + `"
+	description = "public VIPs"
+
+	application {
+		tps_based {
+			mode = "blocking"
+			ip_maximum_tps = 200
+		}
+	}
+
+	network_vector {
+		type = "tcp-syn-flood"
+		rate_threshold = 10000
+	}
+
+	network_vector {
+		type = "icmpv4-flood"
+		state = "detect-only"
+	}
+
+	dns_vector {
+		type = "any"
+	}
+}
+`
+
+func TestAccBigipSecurityDosProfile_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckDosProfilesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DOS_PROFILE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDosProfileExists(TEST_DOS_PROFILE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos-profile", "description", "public VIPs"),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos-profile", "application.0.tps_based.0.mode", "blocking"),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos-profile", "application.0.tps_based.0.ip_maximum_tps", "200"),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos-profile", "application.0.stress_based.#", "0"),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos-profile", "network_vector.#", "2"),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos-profile", "network_vector.0.type", "tcp-syn-flood"),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos-profile", "network_vector.0.rate_threshold", "10000"),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos-profile", "network_vector.1.state", "detect-only"),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos-profile", "dns_vector.#", "1"),
+				),
+			},
+			{
+				Config:   TEST_DOS_PROFILE_RESOURCE,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipSecurityDosProfile_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckDosProfilesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DOS_PROFILE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDosProfileExists(TEST_DOS_PROFILE_NAME, true),
+				),
+				ResourceName:      TEST_DOS_PROFILE_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestFlattenDosAttackVectors(t *testing.T) {
+	vectors := []bigip.DosAttackVector{
+		{Type: "icmpv4-flood", State: "detect-only"},
+		{Type: "tcp-rst-flood", State: "mitigate"},
+		{Type: "tcp-syn-flood", State: "mitigate", RateThreshold: 10000},
+	}
+	configured := []interface{}{
+		map[string]interface{}{"type": "tcp-syn-flood"},
+		map[string]interface{}{"type": "icmpv4-flood"},
+	}
+
+	l := flattenDosAttackVectors(vectors, configured)
+	if len(l) != 2 {
+		t.Fatalf("expected the 2 configured vectors, got %v", l)
+	}
+	first := l[0].(map[string]interface{})
+	if first["type"] != "tcp-syn-flood" || first["rate_threshold"] != 10000 {
+		t.Errorf("unexpected first vector %v", first)
+	}
+	if second := l[1].(map[string]interface{}); second["type"] != "icmpv4-flood" || second["state"] != "detect-only" {
+		t.Errorf("unexpected second vector %v", second)
+	}
+
+	if l := flattenDosAttackVectors(vectors, nil); len(l) != 3 {
+		t.Errorf("expected all vectors without configured vectors, got %v", l)
+	}
+	if l := flattenDosAttackVectors(nil, configured); len(l) != 0 {
+		t.Errorf("expected no vectors without vectors on the BIG-IP, got %v", l)
+	}
+}
+
+func TestFlattenDosApplication(t *testing.T) {
+	app := &bigip.DosApplication{
+		TpsBased:    &bigip.DosApplicationDetection{Mode: "blocking", IpMaximumTps: 200},
+		StressBased: &bigip.DosApplicationDetection{Mode: "off"},
+	}
+	configured := []interface{}{
+		map[string]interface{}{
+			"tps_based":    []interface{}{map[string]interface{}{"mode": "blocking"}},
+			"stress_based": []interface{}{},
+		},
+	}
+
+	m := flattenDosApplication(app, configured)[0].(map[string]interface{})
+	tps := m["tps_based"].([]interface{})
+	if len(tps) != 1 || tps[0].(map[string]interface{})["ip_maximum_tps"] != 200 {
+		t.Errorf("unexpected tps_based %v", tps)
+	}
+	if stress := m["stress_based"].([]interface{}); len(stress) != 0 {
+		t.Errorf("expected no stress_based when it is off and not configured, got %v", stress)
+	}
+
+	configured[0].(map[string]interface{})["stress_based"] = []interface{}{map[string]interface{}{"mode": "off"}}
+	m = flattenDosApplication(app, configured)[0].(map[string]interface{})
+	if stress := m["stress_based"].([]interface{}); len(stress) != 1 {
+		t.Errorf("expected the configured stress_based, got %v", stress)
+	}
+
+	app.StressBased.Mode = "transparent"
+	m = flattenDosApplication(app, nil)[0].(map[string]interface{})
+	if stress := m["stress_based"].([]interface{}); len(stress) != 1 {
+		t.Errorf("expected stress_based that is not off, got %v", stress)
+	}
+
+	if expanded := expandDosApplication([]interface{}{map[string]interface{}{
+		"tps_based":    []interface{}{},
+		"stress_based": []interface{}{},
+	}}); expanded.TpsBased.Mode != "off" || expanded.StressBased.Mode != "off" {
+		t.Errorf("expected unset detections to be turned off, got %+v", expanded)
+	}
+}
+
+func testCheckDosProfileExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		profile, err := client.GetDosProfile(name)
+		if err != nil {
+			return err
+		}
+		if exists && profile == nil {
+			return fmt.Errorf("DoS profile %s was not created.", name)
+		}
+		if !exists && profile != nil {
+			return fmt.Errorf("DoS profile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckDosProfilesDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_security_dos_profile" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		profile, err := client.GetDosProfile(name)
+		if err != nil {
+			return err
+		}
+		if profile != nil {
+			return fmt.Errorf("DoS profile %s not destroyed.", name)
+		}
+	}
+	return nil
+}
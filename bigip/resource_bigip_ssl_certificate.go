@@ -0,0 +1,186 @@
+package bigip
+
+import (
+	"crypto/sha256"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSslCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSslCertificateCreate,
+		Read:   resourceBigipSslCertificateRead,
+		Update: resourceBigipSslCertificateUpdate,
+		Delete: resourceBigipSslCertificateDelete,
+		Exists: resourceBigipSslCertificateExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the certificate, e.g. /Common/www.example.com.crt",
+				ValidateFunc: validateF5Name,
+			},
+
+			"content": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "PEM encoded certificate",
+			},
+
+			"expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the certificate",
+			},
+
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subject of the certificate",
+			},
+
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Issuer of the certificate",
+			},
+
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 fingerprint of the certificate",
+			},
+		},
+	}
+}
+
+func resourceBigipSslCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating SSL Certificate " + name)
+
+	err := installSslCertificate(name, d, meta)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create SSL Certificate (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipSslCertificateRead(d, meta)
+}
+
+func resourceBigipSslCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading SSL Certificate " + name)
+
+	cert, err := client.GetCertificate(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve SSL Certificate (%s) (%v) ", name, err)
+		return err
+	}
+	if cert == nil {
+		log.Printf("[WARN] SSL Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("expiration", cert.ExpirationString)
+	d.Set("subject", cert.Subject)
+	d.Set("issuer", cert.Issuer)
+	d.Set("fingerprint", cert.Fingerprint)
+
+	// The PEM can't be read back, a different fingerprint means the
+	// certificate was replaced outside of Terraform.
+	if content := d.Get("content").(string); content != "" && certificateFingerprint(content) != cert.Fingerprint {
+		log.Printf("[WARN] SSL Certificate (%s) fingerprint changed to %s", name, cert.Fingerprint)
+		d.Set("content", "")
+	}
+
+	return nil
+}
+
+func resourceBigipSslCertificateExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if SSL Certificate exists " + name)
+
+	cert, err := client.GetCertificate(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve SSL Certificate (%s) (%v) ", name, err)
+		return false, err
+	}
+	if cert == nil {
+		log.Printf("[WARN] SSL Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipSslCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Id()
+	log.Println("[INFO] Updating SSL Certificate " + name)
+
+	// Installing over the existing certificate keeps the SSL profiles
+	// referencing it intact.
+	err := installSslCertificate(name, d, meta)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update SSL Certificate (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipSslCertificateRead(d, meta)
+}
+
+func resourceBigipSslCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting SSL Certificate " + name)
+
+	err := client.DeleteCertificate(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete SSL Certificate (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func installSslCertificate(name string, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	path, err := client.UploadBytes([]byte(d.Get("content").(string)), uploadFileName(name))
+	if err != nil {
+		return err
+	}
+	return client.InstallCertificate(name, path)
+}
+
+// certificateFingerprint returns the fingerprint of a PEM certificate in the
+// format used by the BIG-IP, e.g. SHA256/9F:86:D0:...
+func certificateFingerprint(content string) string {
+	block, _ := pem.Decode([]byte(content))
+	if block == nil {
+		return ""
+	}
+	sum := sha256.Sum256(block.Bytes)
+	hex := make([]string, len(sum))
+	for i, b := range sum {
+		hex[i] = fmt.Sprintf("%02X", b)
+	}
+	return "SHA256/" + strings.Join(hex, ":")
+}
@@ -0,0 +1,132 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_SSL_CERTIFICATE_NAME = fmt.Sprintf("/%s/test-cert.crt", TEST_PARTITION)
+
+// Self signed certificate for test.example.com
+var TEST_SSL_CERTIFICATE_PEM = `-----BEGIN CERTIFICATE-----
+MIIDFzCCAf+gAwIBAgIUWty1YpoX/TiEzFEJhf9InDSrFcwwDQYJKoZIhvcNAQEL
+BQAwGzEZMBcGA1UEAwwQdGVzdC5leGFtcGxlLmNvbTAeFw0yNjEwMTkwMzI5MjVa
+Fw0zNjEwMTYwMzI5MjVaMBsxGTAXBgNVBAMMEHRlc3QuZXhhbXBsZS5jb20wggEi
+MA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQCzoKHudMCIt7RYZ1M/wfUSVC2X
+qW6PI4PunA7vfzVL3Yw6Fu4RjRMzUFKw3g7wMuOjk2xgeu//sj7QtOFkCqUflzYl
+RrG7iVjj29MGZXvyphi9wy9Kl8xXv5KxrsOOw0NIox7mrYnTOG8gPrxdjgDYOAgI
+LWdBqT972ASrCi4yuWp+H+hIrf2/26pR8nPsEKkuedMEWMSbv9fHqMomi2kDdJCe
+GKmSYA3DD7CxxZTB4oBv7hyWRNPiae5/E2BbxQbAP4QClBD2XCGrB6+OtFnQqbNU
+SJoTh8/z6xvCve8y6/nVgNjXeG5Mv2ZXKF1UkQHfgRn+Pu+LcI6d/LogndABAgMB
+AAGjUzBRMB0GA1UdDgQWBBRHDkka8vmPhmoIHjHtZWT3gx52lDAfBgNVHSMEGDAW
+gBRHDkka8vmPhmoIHjHtZWT3gx52lDAPBgNVHRMBAf8EBTADAQH/MA0GCSqGSIb3
+DQEBCwUAA4IBAQCeJafWzAL95u+Lu2UpKdWLBXvauvj9TeU3wpfxmaRNbKkzQ09n
+5kbGt8Wu08cU9nhLlOnoT0Cuq5KkkNkJ3f03GC8t8Ev17HyKKp/gl5PR2VmCTqPC
+1j6gWrnpPLC1y98K0cJCrvaaSmDOLrLa4iAs9rxP26xySjFe3Fg2wJbqLLrcoeJK
+zWQwT0FdAaZqrNPIBWteJVcvrxuRmAOM71x5Aa4FGZOVP5UXq1rlrAHBAwhMAFVE
+34rzmwL7PVuZWMqEA0zcIIcnpM5LwQAyCHs0GnQryC4RCWhe0xLC5wYVpMa+kWou
+wtNoyzHFVwFb4ejBVw6m2sxbvH2EMOkf4rr9
+-----END CERTIFICATE-----
+`
+
+var TEST_SSL_CERTIFICATE_RESOURCE = `
+resource "bigip_ssl_certificate" "test-cert" {
+	name = "` + TEST_SSL_CERTIFICATE_NAME + `"
+	content = <<EOF
+` + TEST_SSL_CERTIFICATE_PEM + `EOF
+}
+`
+
+func TestAccBigipSslCertificate_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckSslCertificatesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SSL_CERTIFICATE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSslCertificateExists(TEST_SSL_CERTIFICATE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_ssl_certificate.test-cert", "name", TEST_SSL_CERTIFICATE_NAME),
+					resource.TestCheckResourceAttr("bigip_ssl_certificate.test-cert", "fingerprint", certificateFingerprint(TEST_SSL_CERTIFICATE_PEM)),
+					resource.TestCheckResourceAttrSet("bigip_ssl_certificate.test-cert", "expiration"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSslCertificate_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckSslCertificatesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SSL_CERTIFICATE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSslCertificateExists(TEST_SSL_CERTIFICATE_NAME, true),
+				),
+				ResourceName:      TEST_SSL_CERTIFICATE_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestCertificateFingerprint(t *testing.T) {
+	expected := "SHA256/4F:77:A8:DE:63:FF:2F:67:D1:8B:82:2A:67:89:FA:B3:3B:A0:FB:E5:DC:9E:2E:36:5B:D0:8A:07:DA:F3:64:67"
+	if f := certificateFingerprint(TEST_SSL_CERTIFICATE_PEM); f != expected {
+		t.Errorf("expected fingerprint %s, got %s", expected, f)
+	}
+	if f := certificateFingerprint("not a certificate"); f != "" {
+		t.Errorf("expected no fingerprint, got %s", f)
+	}
+}
+
+func testCheckSslCertificateExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		cert, err := client.GetCertificate(name)
+		if err != nil {
+			return err
+		}
+		if exists && cert == nil {
+			return fmt.Errorf("ssl certificate %s was not created.", name)
+		}
+		if !exists && cert != nil {
+			return fmt.Errorf("ssl certificate %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckSslCertificatesDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ssl_certificate" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		cert, err := client.GetCertificate(name)
+		if err != nil {
+			return err
+		}
+		if cert != nil {
+			return fmt.Errorf("ssl certificate %s not destroyed.", name)
+		}
+	}
+	return nil
+}
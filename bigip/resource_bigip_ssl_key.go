@@ -0,0 +1,153 @@
+package bigip
+
+import (
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSslKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSslKeyCreate,
+		Read:   resourceBigipSslKeyRead,
+		Update: resourceBigipSslKeyUpdate,
+		Delete: resourceBigipSslKeyDelete,
+		Exists: resourceBigipSslKeyExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the key, e.g. /Common/www.example.com.key",
+				ValidateFunc: validateF5Name,
+			},
+
+			"content": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key",
+			},
+
+			"passphrase": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Passphrase of an encrypted key",
+			},
+
+			"key_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the key, e.g. rsa-private",
+			},
+
+			"key_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the key in bits",
+			},
+		},
+	}
+}
+
+func resourceBigipSslKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating SSL Key " + name)
+
+	err := installSslKey(name, d, meta)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create SSL Key (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipSslKeyRead(d, meta)
+}
+
+func resourceBigipSslKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading SSL Key " + name)
+
+	key, err := client.GetKey(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve SSL Key (%s) (%v) ", name, err)
+		return err
+	}
+	if key == nil {
+		log.Printf("[WARN] SSL Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("key_type", key.KeyType)
+	d.Set("key_size", key.KeySize)
+
+	return nil
+}
+
+func resourceBigipSslKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if SSL Key exists " + name)
+
+	key, err := client.GetKey(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve SSL Key (%s) (%v) ", name, err)
+		return false, err
+	}
+	if key == nil {
+		log.Printf("[WARN] SSL Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipSslKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Id()
+	log.Println("[INFO] Updating SSL Key " + name)
+
+	// Installing over the existing key keeps the SSL profiles referencing it intact.
+	err := installSslKey(name, d, meta)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update SSL Key (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipSslKeyRead(d, meta)
+}
+
+func resourceBigipSslKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting SSL Key " + name)
+
+	err := client.DeleteKey(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete SSL Key (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func installSslKey(name string, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	path, err := client.UploadBytes([]byte(d.Get("content").(string)), uploadFileName(name))
+	if err != nil {
+		return err
+	}
+	return client.InstallKey(name, path, d.Get("passphrase").(string))
+}
@@ -0,0 +1,206 @@
+package bigip
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSysApplicationTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysApplicationTemplateCreate,
+		Read:   resourceBigipSysApplicationTemplateRead,
+		Update: resourceBigipSysApplicationTemplateUpdate,
+		Delete: resourceBigipSysApplicationTemplateDelete,
+		Exists: resourceBigipSysApplicationTemplateExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the template, e.g. /Common/my_template. It must match the name declared in the template file",
+				ValidateFunc: validateF5Name,
+			},
+
+			"template": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Content of the .tmpl file",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the template",
+			},
+
+			"requires_modules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Modules that must be provisioned to deploy the template",
+			},
+
+			"role_acl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Roles allowed to deploy the template",
+			},
+
+			"checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 of the implementation, presentation and help sections",
+			},
+		},
+	}
+}
+
+func resourceBigipSysApplicationTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating iApp template " + name)
+
+	err := loadApplicationTemplate(name, d, meta)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create iApp template (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipSysApplicationTemplateRead(d, meta)
+}
+
+func resourceBigipSysApplicationTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading iApp template " + name)
+
+	t, err := client.GetTemplate(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve iApp template (%s) (%v) ", name, err)
+		return err
+	}
+	if t == nil {
+		log.Printf("[WARN] iApp template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("description", t.Description)
+	if err := d.Set("requires_modules", t.RequiresModules); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving RequiresModules to state for iApp template (%s): %s", d.Id(), err)
+	}
+
+	checksum := ""
+	if def := t.Definition(); def != nil {
+		if err := d.Set("role_acl", def.RoleAcl); err != nil {
+			return fmt.Errorf("[DEBUG] Error saving RoleAcl to state for iApp template (%s): %s", d.Id(), err)
+		}
+		checksum = templateChecksum(def)
+	}
+
+	// The .tmpl file can't be read back, a different checksum means the
+	// template was changed outside of Terraform.
+	if old := d.Get("checksum").(string); old != "" && old != checksum {
+		log.Printf("[WARN] iApp template (%s) checksum changed to %s", name, checksum)
+		d.Set("template", "")
+	}
+	d.Set("checksum", checksum)
+
+	return nil
+}
+
+func resourceBigipSysApplicationTemplateExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if iApp template exists " + name)
+
+	t, err := client.GetTemplate(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve iApp template (%s) (%v) ", name, err)
+		return false, err
+	}
+	if t == nil {
+		log.Printf("[WARN] iApp template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipSysApplicationTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Id()
+	log.Println("[INFO] Updating iApp template " + name)
+
+	// Merging over the existing template keeps the applications using it,
+	// they are flagged as modified until reconfigured.
+	err := loadApplicationTemplate(name, d, meta)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update iApp template (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipSysApplicationTemplateRead(d, meta)
+}
+
+func resourceBigipSysApplicationTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting iApp template " + name)
+
+	err := client.DeleteTemplate(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete iApp template (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func loadApplicationTemplate(name string, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	content := d.Get("template").(string)
+	if declared := templateName(content); declared != name {
+		return fmt.Errorf("template file declares %q instead of %q", declared, name)
+	}
+
+	path, err := client.UploadBytes([]byte(content), uploadFileName(name)+".tmpl")
+	if err != nil {
+		return err
+	}
+	return client.LoadTemplate(path)
+}
+
+var templateHeader = regexp.MustCompile(`sys application template\s+(\S+)\s*\{`)
+
+// templateName returns the full path of the template declared in a .tmpl
+// file, templates without a partition are loaded into Common.
+func templateName(content string) string {
+	m := templateHeader.FindStringSubmatch(content)
+	if m == nil {
+		return ""
+	}
+	if m[1][0] != '/' {
+		return "/Common/" + m[1]
+	}
+	return m[1]
+}
+
+func templateChecksum(def *bigip.TemplateAction) string {
+	sum := sha256.Sum256([]byte(def.Implementation + def.Presentation + def.HtmlHelp))
+	return fmt.Sprintf("%x", sum)
+}
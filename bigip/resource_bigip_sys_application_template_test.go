@@ -0,0 +1,124 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_APPLICATION_TEMPLATE_NAME = "/" + TEST_PARTITION + "/test-template"
+
+var TEST_APPLICATION_TEMPLATE_RESOURCE = `
+resource "bigip_sys_application_template" "test-template" {
+	name     = "` + TEST_APPLICATION_TEMPLATE_NAME + `"
+	template = <<EOF
+sys application template ` + TEST_APPLICATION_TEMPLATE_NAME + ` {
+    actions {
+        definition {
+            html-help {
+            }
+            implementation {
+                tmsh::log_dest file
+                tmsh::log_level crit
+            }
+            presentation {
+                section intro {
+                    string name display "medium"
+                }
+            }
+            role-acl { admin manager resource-admin }
+            run-as none
+        }
+    }
+    description "Terraform test template"
+    requires-modules { ltm }
+}
+EOF
+}
+`
+
+func TestAccBigipSysApplicationTemplate_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckApplicationTemplatesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_APPLICATION_TEMPLATE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationTemplateExists(TEST_APPLICATION_TEMPLATE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_sys_application_template.test-template", "requires_modules.0", "ltm"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysApplicationTemplate_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckApplicationTemplatesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_APPLICATION_TEMPLATE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApplicationTemplateExists(TEST_APPLICATION_TEMPLATE_NAME, true),
+				),
+				ResourceName:      TEST_APPLICATION_TEMPLATE_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestTemplateName(t *testing.T) {
+	cases := map[string]string{
+		"sys application template /Tenant/app {\n actions {}\n}":                                 "/Tenant/app",
+		"cli admin-partitions {\n update-partition Common\n}\nsys application template my_app {": "/Common/my_app",
+		"no template": "",
+	}
+	for content, expected := range cases {
+		if name := templateName(content); name != expected {
+			t.Errorf("expected %q, got %q", expected, name)
+		}
+	}
+}
+
+func testCheckApplicationTemplateExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		template, err := client.GetTemplate(name)
+		if err != nil {
+			return err
+		}
+		if exists && template == nil {
+			return fmt.Errorf("iApp template %s was not created.", name)
+		}
+		if !exists && template != nil {
+			return fmt.Errorf("iApp template %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckApplicationTemplatesDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_application_template" {
+			continue
+		}
+		if err := testCheckApplicationTemplateExists(rs.Primary.ID, false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
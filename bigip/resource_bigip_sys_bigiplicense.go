@@ -1,35 +1,49 @@
 package bigip
 
 import (
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func resourceBigipSysBigiplicense() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigipSysBigiplicenseCreate,
-		Update: resourceBigipSysBigiplicenseUpdate,
 		Read:   resourceBigipSysBigiplicenseRead,
 		Delete: resourceBigipSysBigiplicenseDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			"command": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "install",
 				Description: "Tmsh command to execute tmsh commands like install",
 			},
 			"registration_key": {
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "A unique Key F5 provides for Licensing BIG-IP",
 			},
+			"licensed_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "BIG-IP version the license is valid for",
+			},
 		},
 	}
 
@@ -46,28 +60,22 @@ func resourceBigipSysBigiplicenseCreate(d *schema.ResourceData, meta interface{}
 		command,
 		registration_key,
 	)
-	time.Sleep(300 * time.Second)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Apply License to Bigip  (%v) ", err)
 		return err
 	}
-	d.SetId(registration_key)
-	return resourceBigipSysBigiplicenseRead(d, meta)
-}
 
-func resourceBigipSysBigiplicenseUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*bigip.BigIP)
-
-	registration_key := d.Id()
-
-	log.Println("[INFO] Updating Bigiplicense " + registration_key)
-
-	r := &bigip.Bigiplicense{
-		Registration_key: registration_key,
-		Command:          d.Get("command").(string),
+	// Installing the license restarts the services of the BIG-IP, wait until
+	// the key is reported and the device is ready again.
+	err = waitForBigiplicense(client, d.Timeout(schema.TimeoutCreate), true, func(l *bigip.LicenseStatus) bool {
+		return l != nil && l.RegistrationKey == registration_key
+	})
+	if err != nil {
+		log.Printf("[ERROR] Bigip not licensed with (%s) (%v) ", registration_key, err)
+		return err
 	}
-
-	return client.ModifyBigiplicense(r)
+	d.SetId(registration_key)
+	return resourceBigipSysBigiplicenseRead(d, meta)
 }
 
 func resourceBigipSysBigiplicenseRead(d *schema.ResourceData, meta interface{}) error {
@@ -77,21 +85,72 @@ func resourceBigipSysBigiplicenseRead(d *schema.ResourceData, meta interface{})
 
 	log.Println("[INFO] Reading Bigiplicense " + name)
 
-	licenses, err := client.Bigiplicenses()
+	license, err := client.GetLicenseStatus()
 	if err != nil {
 		log.Printf("[ERROR] Unable to Read License from Bigip  (%v) ", err)
 		return err
 	}
-	if licenses == nil {
+	if license == nil || license.RegistrationKey != name {
 		log.Printf("[WARN] License (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	d.Set("registration_key", license.RegistrationKey)
+	d.Set("licensed_version", license.LicensedVersion)
+	if d.Get("command").(string) == "" {
+		d.Set("command", "install")
+	}
+
 	return nil
 }
 
 func resourceBigipSysBigiplicenseDelete(d *schema.ResourceData, meta interface{}) error {
-	//API does not Exists
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Revoking Bigiplicense " + name)
+
+	err := client.RevokeBigiplicense()
+	if err != nil {
+		log.Printf("[ERROR] Unable to Revoke License (%s) (%v) ", name, err)
+		return err
+	}
+
+	err = waitForBigiplicense(client, d.Timeout(schema.TimeoutDelete), false, func(l *bigip.LicenseStatus) bool {
+		return l == nil || l.RegistrationKey != name
+	})
+	if err != nil {
+		log.Printf("[ERROR] License (%s) not revoked (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
 	return nil
 }
+
+// waitForBigiplicense polls sys/license and sys/ready until done accepts the
+// license and the BIG-IP is ready. An unlicensed BIG-IP never reports the
+// license and provisioning ready, only the configuration is waited for unless
+// <licensed> is set. Errors are retried as the REST API is not available while
+// the services restart.
+func waitForBigiplicense(client *bigip.BigIP, timeout time.Duration, licensed bool, done func(*bigip.LicenseStatus) bool) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		license, err := client.GetLicenseStatus()
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+		if !done(license) {
+			return resource.RetryableError(fmt.Errorf("license not yet changed"))
+		}
+
+		ready, err := client.SysReady()
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+		if !ready.ConfigReady || (licensed && (!ready.LicenseReady || !ready.ProvisionReady)) {
+			return resource.RetryableError(fmt.Errorf("BIG-IP not ready: config %t, license %t, provision %t",
+				ready.ConfigReady, ready.LicenseReady, ready.ProvisionReady))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,132 @@
+package bigip
+
+import (
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSysDb() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysDbCreate,
+		Read:   resourceBigipSysDbRead,
+		Update: resourceBigipSysDbUpdate,
+		Delete: resourceBigipSysDbDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the db variable, e.g. ui.advisory.enabled",
+			},
+
+			"value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Value of the db variable",
+			},
+
+			"default_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Default value of the db variable, restored on destroy",
+			},
+
+			"value_range": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Values the db variable accepts",
+			},
+		},
+	}
+}
+
+// The db variables always exist, creating the resource sets the value.
+func resourceBigipSysDbCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Setting db variable " + name)
+
+	err := client.ModifyDbVariable(name, d.Get("value").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create db variable (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipSysDbRead(d, meta)
+}
+
+func resourceBigipSysDbRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading db variable " + name)
+
+	db, err := client.GetDbVariable(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve db variable (%s) (%v) ", name, err)
+		return err
+	}
+	if db == nil {
+		log.Printf("[WARN] db variable (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("value", db.Value)
+	d.Set("default_value", db.DefaultValue)
+	d.Set("value_range", db.ValueRange)
+
+	return nil
+}
+
+func resourceBigipSysDbUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating db variable " + name)
+
+	err := client.ModifyDbVariable(name, d.Get("value").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update db variable (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipSysDbRead(d, meta)
+}
+
+// The db variables can't be deleted, destroying the resource restores the
+// default value.
+func resourceBigipSysDbDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Restoring default of db variable " + name)
+
+	db, err := client.GetDbVariable(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve db variable (%s) (%v) ", name, err)
+		return err
+	}
+	switch {
+	case db == nil || db.Value == db.DefaultValue:
+	case db.DefaultValue == "":
+		log.Printf("[WARN] db variable (%s) has no default value, removing from state only", name)
+	default:
+		err = client.ModifyDbVariable(name, db.DefaultValue)
+		if err != nil {
+			log.Printf("[ERROR] Unable to Delete db variable (%s) (%v) ", name, err)
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,97 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_DB_NAME = "ui.advisory.enabled"
+
+var TEST_DB_RESOURCE = `
+resource "bigip_sys_db" "test-db" {
+	name  = "` + TEST_DB_NAME + `"
+	value = "true"
+}
+`
+
+func TestAccBigipSysDb_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckDbsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DB_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDbValue(TEST_DB_NAME, "true"),
+					resource.TestCheckResourceAttr("bigip_sys_db.test-db", "value", "true"),
+					resource.TestCheckResourceAttr("bigip_sys_db.test-db", "default_value", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysDb_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckDbsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DB_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDbValue(TEST_DB_NAME, "true"),
+				),
+				ResourceName:      TEST_DB_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckDbValue(name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		db, err := client.GetDbVariable(name)
+		if err != nil {
+			return err
+		}
+		if db == nil {
+			return fmt.Errorf("db variable %s does not exist.", name)
+		}
+		if db.Value != value {
+			return fmt.Errorf("db variable %s is %s, expected %s.", name, db.Value, value)
+		}
+		return nil
+	}
+}
+
+// Destroying the resource restores the default value
+func testCheckDbsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_db" {
+			continue
+		}
+		db, err := client.GetDbVariable(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if db != nil && db.Value != db.DefaultValue {
+			return fmt.Errorf("db variable %s is %s, expected the default %s.", rs.Primary.ID, db.Value, db.DefaultValue)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package bigip
+
+import (
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSysFileDatagroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysFileDatagroupCreate,
+		Read:   resourceBigipSysFileDatagroupRead,
+		Update: resourceBigipSysFileDatagroupUpdate,
+		Delete: resourceBigipSysFileDatagroupDelete,
+		Exists: resourceBigipSysFileDatagroupExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeSysFileDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the data group file, e.g. /Common/blocklist.txt",
+				ValidateFunc: validateF5Name,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The type of the records (string, ip, integer)",
+				ValidateFunc: validateDataGroupType,
+			},
+
+			"content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Records of the data group file in the BIG-IP format, one record per line",
+				ConflictsWith: []string{"source_path"},
+			},
+
+			"source_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Local path of the file to upload",
+				ConflictsWith: []string{"content"},
+			},
+
+			"content_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Checksum of the data group file in the format of the BIG-IP, SHA1:<size>:<hex digest>",
+			},
+		},
+	}
+}
+
+func resourceBigipSysFileDatagroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating data group file " + name)
+
+	path, err := uploadSysFile(name, d, meta)
+	if err == nil {
+		err = client.CreateDataGroupFile(name, d.Get("type").(string), path)
+	}
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create data group file (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipSysFileDatagroupRead(d, meta)
+}
+
+func resourceBigipSysFileDatagroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading data group file " + name)
+
+	file, err := client.GetDataGroupFile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve data group file (%s) (%v) ", name, err)
+		return err
+	}
+	if file == nil {
+		log.Printf("[WARN] Data group file (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("type", file.Type)
+	// The content can't be read back, a content changed outside of Terraform
+	// shows up as a different checksum.
+	d.Set("content_hash", file.Checksum)
+
+	return nil
+}
+
+func resourceBigipSysFileDatagroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if data group file exists " + name)
+
+	file, err := client.GetDataGroupFile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve data group file (%s) (%v) ", name, err)
+		return false, err
+	}
+	if file == nil {
+		log.Printf("[WARN] Data group file (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipSysFileDatagroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating data group file " + name)
+
+	// Replacing the source of the file keeps the external data groups
+	// referencing it, the records are swapped without dropping the data group.
+	path, err := uploadSysFile(name, d, meta)
+	if err == nil {
+		err = client.ModifyDataGroupFile(name, path)
+	}
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update data group file (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipSysFileDatagroupRead(d, meta)
+}
+
+func resourceBigipSysFileDatagroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting data group file " + name)
+
+	err := client.DeleteDataGroupFile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete data group file (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,91 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_DATAGROUP_FILE_NAME = "/" + TEST_PARTITION + "/test-blocklist.txt"
+
+var TEST_DATAGROUP_FILE_RESOURCE = `
+resource "bigip_sys_file_datagroup" "test-blocklist" {
+	name    = "` + TEST_DATAGROUP_FILE_NAME + `"
+	type    = "ip"
+	content = "network 192.0.2.0/24,\nhost 198.51.100.1,\n"
+}
+`
+
+func TestAccBigipSysFileDatagroup_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckDatagroupFilesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DATAGROUP_FILE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDatagroupFileExists(TEST_DATAGROUP_FILE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_sys_file_datagroup.test-blocklist", "content_hash",
+						sysFileChecksum([]byte("network 192.0.2.0/24,\nhost 198.51.100.1,\n"))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysFileDatagroup_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckDatagroupFilesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_DATAGROUP_FILE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDatagroupFileExists(TEST_DATAGROUP_FILE_NAME, true),
+				),
+				ResourceName:      TEST_DATAGROUP_FILE_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckDatagroupFileExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		file, err := client.GetDataGroupFile(name)
+		if err != nil {
+			return err
+		}
+		if exists && file == nil {
+			return fmt.Errorf("data group file %s was not created.", name)
+		}
+		if !exists && file != nil {
+			return fmt.Errorf("data group file %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckDatagroupFilesDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_file_datagroup" {
+			continue
+		}
+		if err := testCheckDatagroupFileExists(rs.Primary.ID, false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
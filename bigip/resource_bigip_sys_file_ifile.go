@@ -0,0 +1,198 @@
+package bigip
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSysFileIfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysFileIfileCreate,
+		Read:   resourceBigipSysFileIfileRead,
+		Update: resourceBigipSysFileIfileUpdate,
+		Delete: resourceBigipSysFileIfileDelete,
+		Exists: resourceBigipSysFileIfileExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeSysFileDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the iFile, e.g. /Common/maintenance.html",
+				ValidateFunc: validateF5Name,
+			},
+
+			"content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Content of the iFile",
+				ConflictsWith: []string{"source_path"},
+			},
+
+			"source_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Local path of the file to upload",
+				ConflictsWith: []string{"content"},
+			},
+
+			"content_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Checksum of the iFile in the format of the BIG-IP, SHA1:<size>:<hex digest>",
+			},
+		},
+	}
+}
+
+func resourceBigipSysFileIfileCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating iFile " + name)
+
+	path, err := uploadSysFile(name, d, meta)
+	if err == nil {
+		err = client.CreateIFile(name, path)
+	}
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create iFile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipSysFileIfileRead(d, meta)
+}
+
+func resourceBigipSysFileIfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading iFile " + name)
+
+	ifile, err := client.GetIFile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve iFile (%s) (%v) ", name, err)
+		return err
+	}
+	if ifile == nil {
+		log.Printf("[WARN] iFile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	// The content can't be read back, a content changed outside of Terraform
+	// shows up as a different checksum.
+	d.Set("content_hash", ifile.Checksum)
+
+	return nil
+}
+
+func resourceBigipSysFileIfileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if iFile exists " + name)
+
+	ifile, err := client.GetIFile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve iFile (%s) (%v) ", name, err)
+		return false, err
+	}
+	if ifile == nil {
+		log.Printf("[WARN] iFile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipSysFileIfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating iFile " + name)
+
+	// Replacing the source of the iFile keeps the LTM iFiles and the iRules
+	// referencing it intact.
+	path, err := uploadSysFile(name, d, meta)
+	if err == nil {
+		err = client.ModifyIFile(name, path)
+	}
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update iFile (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipSysFileIfileRead(d, meta)
+}
+
+func resourceBigipSysFileIfileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting iFile " + name)
+
+	err := client.DeleteIFile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete iFile (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// customizeSysFileDiff detects changes of uploaded files, e.g. iFiles or data
+// group files. The content of a source_path isn't part of the configuration,
+// changes of the local file or of the file on the BIG-IP are detected by their
+// checksums.
+func customizeSysFileDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("content") || !d.NewValueKnown("source_path") {
+		return d.SetNewComputed("content_hash")
+	}
+	data, err := sysFileData(d.Get("content").(string), d.Get("source_path").(string))
+	if err != nil {
+		return err
+	}
+	if checksum := sysFileChecksum(data); checksum != d.Get("content_hash").(string) {
+		return d.SetNew("content_hash", checksum)
+	}
+	return nil
+}
+
+func uploadSysFile(name string, d *schema.ResourceData, meta interface{}) (string, error) {
+	client := meta.(*bigip.BigIP)
+
+	data, err := sysFileData(d.Get("content").(string), d.Get("source_path").(string))
+	if err != nil {
+		return "", err
+	}
+	return client.UploadBytes(data, uploadFileName(name))
+}
+
+func sysFileData(content, sourcePath string) ([]byte, error) {
+	if sourcePath == "" {
+		return []byte(content), nil
+	}
+	data, err := ioutil.ReadFile(sourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read file %s: %s", sourcePath, err)
+	}
+	return data, nil
+}
+
+// sysFileChecksum returns the checksum of the content in the format of the
+// BIG-IP, e.g. SHA1:11:2aae6c35c94fcfb415dbe95f408b9ce91ee846ed
+func sysFileChecksum(data []byte) string {
+	return fmt.Sprintf("SHA1:%d:%x", len(data), sha1.Sum(data))
+}
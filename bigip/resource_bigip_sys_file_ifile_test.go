@@ -0,0 +1,97 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_SYS_IFILE_NAME = "/" + TEST_PARTITION + "/test-ifile.html"
+
+var TEST_SYS_IFILE_RESOURCE = `
+resource "bigip_sys_file_ifile" "test-ifile" {
+	name    = "` + TEST_SYS_IFILE_NAME + `"
+	content = "<html><body>Down for maintenance</body></html>"
+}
+`
+
+func TestAccBigipSysFileIfile_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckSysIfilesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SYS_IFILE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSysIfileExists(TEST_SYS_IFILE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_sys_file_ifile.test-ifile", "content_hash",
+						sysFileChecksum([]byte("<html><body>Down for maintenance</body></html>"))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysFileIfile_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckSysIfilesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SYS_IFILE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSysIfileExists(TEST_SYS_IFILE_NAME, true),
+				),
+				ResourceName:      TEST_SYS_IFILE_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestIfileChecksum(t *testing.T) {
+	expected := "SHA1:11:2aae6c35c94fcfb415dbe95f408b9ce91ee846ed"
+	if checksum := sysFileChecksum([]byte("hello world")); checksum != expected {
+		t.Errorf("expected %s, got %s", expected, checksum)
+	}
+}
+
+func testCheckSysIfileExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		ifile, err := client.GetIFile(name)
+		if err != nil {
+			return err
+		}
+		if exists && ifile == nil {
+			return fmt.Errorf("iFile %s was not created.", name)
+		}
+		if !exists && ifile != nil {
+			return fmt.Errorf("iFile %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckSysIfilesDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_file_ifile" {
+			continue
+		}
+		if err := testCheckSysIfileExists(rs.Primary.ID, false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
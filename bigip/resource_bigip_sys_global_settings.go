@@ -0,0 +1,164 @@
+package bigip
+
+import (
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSysGlobalSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysGlobalSettingsCreate,
+		Read:   resourceBigipSysGlobalSettingsRead,
+		Update: resourceBigipSysGlobalSettingsUpdate,
+		Delete: resourceBigipSysGlobalSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Fully qualified host name of the BIG-IP",
+			},
+
+			"gui_setup": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Enables or disables the setup utility of the Configuration utility",
+				ValidateFunc: validateEnabledDisabled,
+			},
+
+			"gui_audit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Enables or disables the audit logging of the Configuration utility",
+				ValidateFunc: validateEnabledDisabled,
+			},
+
+			"gui_security_banner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Enables or disables the security banner on the login page",
+				ValidateFunc: validateEnabledDisabled,
+			},
+
+			"gui_security_banner_text": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Text of the security banner on the login page",
+			},
+
+			"console_inactivity_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds of inactivity before the console logs out, 0 disables the timeout",
+			},
+
+			"mgmt_dhcp": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Enables or disables DHCP on the management interface",
+				ValidateFunc: validateEnabledDisabled,
+			},
+
+			"lcd_display": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Enables or disables the LCD display of the appliance",
+				ValidateFunc: validateEnabledDisabled,
+			},
+		},
+	}
+}
+
+// The global settings always exist, creating the resource changes the
+// configured settings.
+func resourceBigipSysGlobalSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Creating global settings")
+
+	err := client.ModifyGlobalSettings(dataToGlobalSettings(d))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create global settings (%v) ", err)
+		return err
+	}
+	d.SetId("global-settings")
+
+	return resourceBigipSysGlobalSettingsRead(d, meta)
+}
+
+func resourceBigipSysGlobalSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Reading global settings")
+
+	settings, err := client.GetGlobalSettings()
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve global settings (%v) ", err)
+		return err
+	}
+
+	d.Set("hostname", settings.Hostname)
+	d.Set("gui_setup", settings.GuiSetup)
+	d.Set("gui_audit", settings.GuiAudit)
+	d.Set("gui_security_banner", settings.GuiSecurityBanner)
+	d.Set("gui_security_banner_text", settings.GuiSecurityBannerText)
+	if settings.ConsoleInactivityTimeout != nil {
+		d.Set("console_inactivity_timeout", *settings.ConsoleInactivityTimeout)
+	}
+	d.Set("mgmt_dhcp", settings.MgmtDhcp)
+	d.Set("lcd_display", settings.LcdDisplay)
+
+	return nil
+}
+
+func resourceBigipSysGlobalSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Updating global settings")
+
+	err := client.ModifyGlobalSettings(dataToGlobalSettings(d))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update global settings (%v) ", err)
+		return err
+	}
+
+	return resourceBigipSysGlobalSettingsRead(d, meta)
+}
+
+func resourceBigipSysGlobalSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	// There is no Delete API for this operation, the settings are kept
+
+	d.SetId("")
+	return nil
+}
+
+func dataToGlobalSettings(d *schema.ResourceData) *bigip.GlobalSettings {
+	settings := &bigip.GlobalSettings{
+		Hostname:              d.Get("hostname").(string),
+		GuiSetup:              d.Get("gui_setup").(string),
+		GuiAudit:              d.Get("gui_audit").(string),
+		GuiSecurityBanner:     d.Get("gui_security_banner").(string),
+		GuiSecurityBannerText: d.Get("gui_security_banner_text").(string),
+		MgmtDhcp:              d.Get("mgmt_dhcp").(string),
+		LcdDisplay:            d.Get("lcd_display").(string),
+	}
+	// 0 disables the timeout, it is only left out when not configured
+	if v, ok := d.GetOkExists("console_inactivity_timeout"); ok {
+		timeout := v.(int)
+		settings.ConsoleInactivityTimeout = &timeout
+	}
+	return settings
+}
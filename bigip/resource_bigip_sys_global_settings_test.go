@@ -0,0 +1,75 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_GLOBAL_SETTINGS_RESOURCE = `
+resource "bigip_sys_global_settings" "test-global-settings" {
+	gui_setup                  = "disabled"
+	console_inactivity_timeout = 0
+}
+`
+
+func TestAccBigipSysGlobalSettings_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GLOBAL_SETTINGS_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGlobalSettings("disabled", 0),
+					resource.TestCheckResourceAttr("bigip_sys_global_settings.test-global-settings", "gui_setup", "disabled"),
+					resource.TestCheckResourceAttr("bigip_sys_global_settings.test-global-settings", "console_inactivity_timeout", "0"),
+					resource.TestCheckResourceAttrSet("bigip_sys_global_settings.test-global-settings", "hostname"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysGlobalSettings_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_GLOBAL_SETTINGS_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGlobalSettings("disabled", 0),
+				),
+				ResourceName:      "global-settings",
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckGlobalSettings(guiSetup string, timeout int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		settings, err := client.GetGlobalSettings()
+		if err != nil {
+			return err
+		}
+		if settings.GuiSetup != guiSetup {
+			return fmt.Errorf("gui setup is %s, expected %s.", settings.GuiSetup, guiSetup)
+		}
+		if settings.ConsoleInactivityTimeout == nil || *settings.ConsoleInactivityTimeout != timeout {
+			return fmt.Errorf("console inactivity timeout is not %d.", timeout)
+		}
+		return nil
+	}
+}
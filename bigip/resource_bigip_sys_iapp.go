@@ -18,106 +18,123 @@ func resourceBigipSysIapp() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		SchemaVersion: 1,
+		MigrateState:  resourceBigipSysIappMigrateState,
 
 		Schema: map[string]*schema.Schema{
 
 			"jsonfile": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Address of the Iapp which needs to be Iappensed",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Application service in JSON, as an alternative to the typed variables, tables and lists",
+				ConflictsWith: []string{"variables", "tables", "lists"},
 			},
 			"name": {
 				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Address of the Iapp which needs to be Iappensed",
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the application service",
 			},
 
 			"partition": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "Common",
-				Description: "Address of the Iapp which needs to be Iappensed",
+				Description: "Partition of the application service",
 			},
 
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 				//Default:     "This is iApp template for application objects",
-				Description: "Address of the Iapp which needs to be Iappensed",
+				Description: "User defined description",
 			},
 
 			"devicegroup": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "none",
-				Description: "BIG-IP password",
+				Description: "Device group of the application service, used when inherited_devicegroup is false",
 			},
 			"execute_action": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "BIG-IP password",
+				Description: "Template action to run, updates run the definition action by default",
 			},
 			"inherited_devicegroup": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "true",
-				Description: "BIG-IP password",
+				Description: "Whether the device group is inherited from the partition",
 			},
 
 			"inherited_traffic_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "true",
-				Description: "BIG-IP password",
+				Description: "Whether the traffic group is inherited from the partition",
 			},
 			"strict_updates": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "enabled",
-				Description: "BIG-IP password",
+				Description: "Whether the objects of the application can only be modified through the application service",
 			},
 
 			"template": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "BIG-IP password",
+				Description: "Template of the application service, e.g. /Common/f5.http",
 			},
 
 			"template_modified": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "no",
-				Description: "BIG-IP password",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "no",
+				Description:      "Whether the template changed since the application service was configured",
+				DiffSuppressFunc: suppressIappTemplateModified,
 			},
 			"template_prerequisite_errors": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "BIG-IP password",
+				Description: "Missing prerequisites of the template",
 			},
 
 			"traffic_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Default:     "/Common/traffic-group-1",
-				Description: "BIG-IP password",
+				Description: "Traffic group of the application service, used when inherited_traffic_group is false",
+			},
+
+			"reconfigure_on_template_change": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reconfigure the application service when its template changed",
 			},
+
 			"lists": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the list",
+						},
 						"encrypted": {
 							Type:        schema.TypeString,
 							Optional:    true,
 							Default:     "no",
-							Description: "Name of origin",
-							//ValidateFunc: validateF5Name,
+							Description: "Whether the values are encrypted",
 						},
 						"value": {
-							Type:        schema.TypeString,
+							Type:        schema.TypeList,
 							Optional:    true,
-							Description: "Name of origin",
-							//ValidateFunc: validateF5Name,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Values of the list",
 						},
 					},
 				},
@@ -132,12 +149,12 @@ func resourceBigipSysIapp() *schema.Resource {
 							Type:        schema.TypeString,
 							Optional:    true,
 							Default:     "true",
-							Description: "Name of origin",
+							Description: "Whether the metadata persists when the application is reconfigured",
 						},
 						"value": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "Name of origin",
+							Description: "Value of the metadata",
 						},
 					},
 				},
@@ -150,8 +167,8 @@ func resourceBigipSysIapp() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"name": {
 							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Name of origin",
+							Required:    true,
+							Description: "Name of the table",
 						},
 						"column_names": {
 							Type:     schema.TypeList,
@@ -160,9 +177,10 @@ func resourceBigipSysIapp() *schema.Resource {
 						},
 
 						"encrypted_columns": {
-							Type:        schema.TypeString,
+							Type:        schema.TypeList,
 							Optional:    true,
-							Description: "Name of origin",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Columns with encrypted values",
 						},
 
 						"rows": {
@@ -191,20 +209,20 @@ func resourceBigipSysIapp() *schema.Resource {
 
 						"name": {
 							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Name of origin",
+							Required:    true,
+							Description: "Name of the variable",
 						},
 
 						"encrypted": {
 							Type:        schema.TypeString,
 							Optional:    true,
 							Default:     "no",
-							Description: "Name of origin",
+							Description: "Whether the value is encrypted",
 						},
 						"value": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "Name of origin",
+							Description: "Value of the variable",
 						},
 					},
 				},
@@ -215,13 +233,13 @@ func resourceBigipSysIapp() *schema.Resource {
 func resourceBigipSysIappCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*bigip.BigIP)
 	name := d.Get("name").(string)
-	description := d.Get("description").(string)
 
 	log.Println("[INFO] Creating Iapp       " + name)
-	p := dataToIapp(name, d)
-	d.SetId(name)
-	d.SetId(description)
-	err := client.CreateIapp(&p)
+	p, err := dataToIapp(name, d)
+	if err != nil {
+		return err
+	}
+	err = client.CreateIapp(&p)
 
 	if err != nil {
 		log.Printf("[ERROR] Unable to Create Iapp  (%s) (%v) ", name, err)
@@ -235,8 +253,16 @@ func resourceBigipSysIappUpdate(d *schema.ResourceData, meta interface{}) error
 	client := meta.(*bigip.BigIP)
 	name := d.Id()
 	log.Println("[INFO] Updating Iapp " + name)
-	p := dataToIapp(name, d)
-	err := client.UpdateIapp(name, &p)
+	p, err := dataToIapp(name, d)
+	if err != nil {
+		return err
+	}
+	// Changes to the inputs or the template only take effect once the
+	// application is reconfigured.
+	if p.ExecuteAction == "" {
+		p.ExecuteAction = "definition"
+	}
+	err = client.UpdateIapp(name, &p)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve Iapp  (%s) ", err)
 		return err
@@ -251,7 +277,6 @@ func resourceBigipSysIappRead(d *schema.ResourceData, meta interface{}) error {
 	name := d.Id()
 
 	log.Println("[INFO] Reading Iapp " + name)
-	// Create a slice and append three strings to it.
 
 	p, err := client.Iapp(name)
 	if err != nil {
@@ -287,10 +312,25 @@ func resourceBigipSysIappRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("traffic_group", p.TrafficGroup); err != nil {
 		return fmt.Errorf("[DEBUG] Error Saving TrafficGroup to state for Iapp  (%s): %s", d.Id(), err)
 	}
-	d.Set("tables", p.Tables)
-	d.Set("lists", p.Lists)
-	d.Set("variables", p.Variables)
-	d.Set("metadata", p.Metadata)
+
+	// The inputs of a jsonfile aren't compared
+	if d.Get("jsonfile").(string) != "" {
+		return nil
+	}
+	d.Set("description", p.Description)
+	d.Set("template", p.Template)
+	if err := d.Set("variables", flattenIappVariables(d.Get("variables").([]interface{}), p.Variables)); err != nil {
+		return fmt.Errorf("[DEBUG] Error Saving Variables to state for Iapp  (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tables", flattenIappTables(d.Get("tables").([]interface{}), p.Tables)); err != nil {
+		return fmt.Errorf("[DEBUG] Error Saving Tables to state for Iapp  (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("lists", flattenIappLists(d.Get("lists").([]interface{}), p.Lists)); err != nil {
+		return fmt.Errorf("[DEBUG] Error Saving Lists to state for Iapp  (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("metadata", flattenIappMetadata(p.Metadata)); err != nil {
+		return fmt.Errorf("[DEBUG] Error Saving Metadata to state for Iapp  (%s): %s", d.Id(), err)
+	}
 	return nil
 }
 
@@ -312,13 +352,189 @@ func IappToData(p *bigip.Iapp, d *schema.ResourceData) error {
 	return nil
 }
 
-func dataToIapp(name string, d *schema.ResourceData) bigip.Iapp {
+func dataToIapp(name string, d *schema.ResourceData) (bigip.Iapp, error) {
 	var p bigip.Iapp
 
-	jsonblob := []byte(d.Get("jsonfile").(string))
-	err := json.Unmarshal(jsonblob, &p)
-	if err != nil {
-		fmt.Println("error", err)
+	if jsonblob := d.Get("jsonfile").(string); jsonblob != "" {
+		if err := json.Unmarshal([]byte(jsonblob), &p); err != nil {
+			return p, fmt.Errorf("Unable to parse jsonfile of Iapp %s: %s", name, err)
+		}
+		return p, nil
+	}
+
+	p.Name = name
+	p.Partition = d.Get("partition").(string)
+	p.Description = d.Get("description").(string)
+	p.ExecuteAction = d.Get("execute_action").(string)
+	p.InheritedDevicegroup = d.Get("inherited_devicegroup").(string)
+	if p.InheritedDevicegroup == "false" {
+		p.DeviceGroup = d.Get("devicegroup").(string)
+	}
+	p.InheritedTrafficGroup = d.Get("inherited_traffic_group").(string)
+	if p.InheritedTrafficGroup == "false" {
+		p.TrafficGroup = d.Get("traffic_group").(string)
+	}
+	p.StrictUpdates = d.Get("strict_updates").(string)
+	p.Template = d.Get("template").(string)
+
+	for _, v := range d.Get("variables").([]interface{}) {
+		m := v.(map[string]interface{})
+		p.Variables = append(p.Variables, bigip.IappVariable{
+			Name:      m["name"].(string),
+			Encrypted: m["encrypted"].(string),
+			Value:     m["value"].(string),
+		})
+	}
+	for _, v := range d.Get("tables").([]interface{}) {
+		m := v.(map[string]interface{})
+		t := bigip.IappTable{
+			Name:             m["name"].(string),
+			ColumnNames:      listToStringSlice(m["column_names"].([]interface{})),
+			EncryptedColumns: listToStringSlice(m["encrypted_columns"].([]interface{})),
+		}
+		for _, r := range m["rows"].([]interface{}) {
+			row := []string{}
+			if r != nil {
+				row = listToStringSlice(r.(map[string]interface{})["row"].([]interface{}))
+			}
+			t.Rows = append(t.Rows, bigip.IappRow{Row: row})
+		}
+		p.Tables = append(p.Tables, t)
+	}
+	for _, v := range d.Get("lists").([]interface{}) {
+		m := v.(map[string]interface{})
+		p.Lists = append(p.Lists, bigip.IappList{
+			Name:      m["name"].(string),
+			Encrypted: m["encrypted"].(string),
+			Value:     listToStringSlice(m["value"].([]interface{})),
+		})
+	}
+	for _, v := range d.Get("metadata").([]interface{}) {
+		m := v.(map[string]interface{})
+		p.Metadata = append(p.Metadata, bigip.IappMetadata{
+			Persist: m["persists"].(string),
+			Value:   m["value"].(string),
+		})
 	}
-	return p
+	return p, nil
+}
+
+// flattenIappVariables returns the deployed values of the configured
+// variables in their configured order, so a change of a single value shows up
+// as a change of that field. Variables added by the template are ignored and
+// encrypted values, which can't be read back, keep their configured value.
+// Without configured variables (e.g. on import) all variables are returned.
+func flattenIappVariables(configured []interface{}, remote []bigip.IappVariable) []interface{} {
+	byName := make(map[string]bigip.IappVariable, len(remote))
+	for _, v := range remote {
+		byName[v.Name] = v
+	}
+	if len(configured) == 0 {
+		configured = make([]interface{}, len(remote))
+		for i, v := range remote {
+			configured[i] = map[string]interface{}{"name": v.Name}
+		}
+	}
+
+	var result []interface{}
+	for _, c := range configured {
+		m := c.(map[string]interface{})
+		v, ok := byName[m["name"].(string)]
+		if !ok {
+			continue
+		}
+		value := v.Value
+		if v.Encrypted == "yes" && m["value"] != nil {
+			value = m["value"].(string)
+		}
+		result = append(result, map[string]interface{}{
+			"name":      v.Name,
+			"encrypted": v.Encrypted,
+			"value":     value,
+		})
+	}
+	return result
+}
+
+// flattenIappTables returns the deployed configured tables, see flattenIappVariables.
+func flattenIappTables(configured []interface{}, remote []bigip.IappTable) []interface{} {
+	byName := make(map[string]bigip.IappTable, len(remote))
+	for _, t := range remote {
+		byName[t.Name] = t
+	}
+	if len(configured) == 0 {
+		configured = make([]interface{}, len(remote))
+		for i, t := range remote {
+			configured[i] = map[string]interface{}{"name": t.Name}
+		}
+	}
+
+	var result []interface{}
+	for _, c := range configured {
+		t, ok := byName[c.(map[string]interface{})["name"].(string)]
+		if !ok {
+			continue
+		}
+		rows := make([]interface{}, len(t.Rows))
+		for i, r := range t.Rows {
+			rows[i] = map[string]interface{}{"row": r.Row}
+		}
+		result = append(result, map[string]interface{}{
+			"name":              t.Name,
+			"column_names":      t.ColumnNames,
+			"encrypted_columns": t.EncryptedColumns,
+			"rows":              rows,
+		})
+	}
+	return result
+}
+
+// flattenIappLists returns the deployed configured lists, see flattenIappVariables.
+func flattenIappLists(configured []interface{}, remote []bigip.IappList) []interface{} {
+	byName := make(map[string]bigip.IappList, len(remote))
+	for _, l := range remote {
+		byName[l.Name] = l
+	}
+	if len(configured) == 0 {
+		configured = make([]interface{}, len(remote))
+		for i, l := range remote {
+			configured[i] = map[string]interface{}{"name": l.Name}
+		}
+	}
+
+	var result []interface{}
+	for _, c := range configured {
+		m := c.(map[string]interface{})
+		l, ok := byName[m["name"].(string)]
+		if !ok {
+			continue
+		}
+		value := makeStringList(&l.Value)
+		if l.Encrypted == "yes" && m["value"] != nil {
+			value = m["value"].([]interface{})
+		}
+		result = append(result, map[string]interface{}{
+			"name":      l.Name,
+			"encrypted": l.Encrypted,
+			"value":     value,
+		})
+	}
+	return result
+}
+
+func flattenIappMetadata(remote []bigip.IappMetadata) []interface{} {
+	result := make([]interface{}, len(remote))
+	for i, m := range remote {
+		result[i] = map[string]interface{}{
+			"persists": m.Persist,
+			"value":    m.Value,
+		}
+	}
+	return result
+}
+
+// suppressIappTemplateModified only reports a modified template when the
+// application service should be reconfigured on template changes.
+func suppressIappTemplateModified(k, old, new string, d *schema.ResourceData) bool {
+	return !d.Get("reconfigure_on_template_change").(bool)
 }
@@ -0,0 +1,58 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func resourceBigipSysIappMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found Iapp State v0; migrating to v1")
+		return migrateBigipSysIappStateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// In v0 the value of a list and the encrypted columns of a table were single
+// strings, v1 holds them as lists.
+func migrateBigipSysIappStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() || is.Attributes == nil {
+		log.Println("[DEBUG] Empty Iapp State; nothing to migrate.")
+		return is, nil
+	}
+	log.Printf("[DEBUG] Iapp Attributes before migration: %#v", is.Attributes)
+
+	for k, v := range is.Attributes {
+		parts := strings.Split(k, ".")
+		if len(parts) != 3 {
+			continue
+		}
+		switch {
+		case parts[0] == "lists" && parts[2] == "value":
+			migrateIappStringToList(is.Attributes, k, []string{v})
+		case parts[0] == "tables" && parts[2] == "encrypted_columns":
+			migrateIappStringToList(is.Attributes, k, strings.Split(v, ","))
+		}
+	}
+
+	log.Printf("[DEBUG] Iapp Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
+
+func migrateIappStringToList(attributes map[string]string, k string, values []string) {
+	delete(attributes, k)
+	var n int
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+		attributes[fmt.Sprintf("%s.%d", k, n)] = v
+		n++
+	}
+	attributes[k+".#"] = fmt.Sprintf("%d", n)
+}
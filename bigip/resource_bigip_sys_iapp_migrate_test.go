@@ -0,0 +1,70 @@
+package bigip
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestBigipSysIappMigrateState(t *testing.T) {
+	cases := map[string]struct {
+		Attributes map[string]string
+		Expected   map[string]string
+	}{
+		"list value": {
+			Attributes: map[string]string{
+				"name":              "http",
+				"lists.#":           "1",
+				"lists.0.encrypted": "no",
+				"lists.0.value":     "10.0.0.1",
+			},
+			Expected: map[string]string{
+				"name":              "http",
+				"lists.#":           "1",
+				"lists.0.encrypted": "no",
+				"lists.0.value.#":   "1",
+				"lists.0.value.0":   "10.0.0.1",
+			},
+		},
+		"empty list value": {
+			Attributes: map[string]string{
+				"lists.#":       "1",
+				"lists.0.value": "",
+			},
+			Expected: map[string]string{
+				"lists.#":         "1",
+				"lists.0.value.#": "0",
+			},
+		},
+		"table encrypted columns": {
+			Attributes: map[string]string{
+				"tables.#":                   "1",
+				"tables.0.name":              "pool__members",
+				"tables.0.encrypted_columns": "addr, port",
+			},
+			Expected: map[string]string{
+				"tables.#":                     "1",
+				"tables.0.name":                "pool__members",
+				"tables.0.encrypted_columns.#": "2",
+				"tables.0.encrypted_columns.0": "addr",
+				"tables.0.encrypted_columns.1": "port",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         "http",
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceBigipSysIappMigrateState(0, is, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if !reflect.DeepEqual(is.Attributes, tc.Expected) {
+			t.Errorf("%s: expected %#v, got %#v", tn, tc.Expected, is.Attributes)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package bigip
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"testing"
 
 	"github.com/f5devcentral/go-bigip"
@@ -181,6 +182,78 @@ func TestAccBigipSysIapp_import(t *testing.T) {
 	})
 }
 
+var TEST_IAPP_TYPED_RESOURCE = `
+resource "bigip_sys_iapp" "test-iapp-typed" {
+	name     = "test-iapp-typed"
+	template = "/Common/f5.http"
+	reconfigure_on_template_change = true
+
+	variables {
+		name  = "pool__addr"
+		value = "10.0.2.200"
+	}
+	variables {
+		name  = "pool__port"
+		value = "80"
+	}
+
+	tables {
+		name         = "pool__members"
+		column_names = ["addr", "port", "connection_limit"]
+		rows {
+			row = ["10.0.2.167", "80", "0"]
+		}
+	}
+}
+`
+
+func TestAccBigipSysIapp_typed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckIappDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_IAPP_TYPED_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_iapp.test-iapp-typed", "variables.#", "2"),
+					resource.TestCheckResourceAttr("bigip_sys_iapp.test-iapp-typed", "variables.0.value", "10.0.2.200"),
+					resource.TestCheckResourceAttr("bigip_sys_iapp.test-iapp-typed", "tables.0.rows.0.row.0", "10.0.2.167"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenIappVariables(t *testing.T) {
+	configured := []interface{}{
+		map[string]interface{}{"name": "pool__port", "encrypted": "no", "value": "80"},
+		map[string]interface{}{"name": "pool__secret", "encrypted": "yes", "value": "secret"},
+		map[string]interface{}{"name": "pool__addr", "encrypted": "no", "value": "10.0.0.1"},
+	}
+	remote := []bigip.IappVariable{
+		{Name: "pool__addr", Encrypted: "no", Value: "10.0.0.2"},
+		{Name: "pool__mask", Encrypted: "no", Value: "255.255.255.255"},
+		{Name: "pool__port", Encrypted: "no", Value: "80"},
+		{Name: "pool__secret", Encrypted: "yes", Value: "$M$xyz"},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"name": "pool__port", "encrypted": "no", "value": "80"},
+		map[string]interface{}{"name": "pool__secret", "encrypted": "yes", "value": "secret"},
+		map[string]interface{}{"name": "pool__addr", "encrypted": "no", "value": "10.0.0.2"},
+	}
+	if result := flattenIappVariables(configured, remote); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+
+	if result := flattenIappVariables(nil, remote); len(result) != len(remote) {
+		t.Errorf("all variables should be returned without configuration, got %v", result)
+	}
+}
+
 func testCheckIappExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*bigip.BigIP)
@@ -0,0 +1,179 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSysManagementIp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysManagementIpCreate,
+		Read:   resourceBigipSysManagementIpRead,
+		Update: resourceBigipSysManagementIpUpdate,
+		Delete: resourceBigipSysManagementIpDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeManagementIpDiff,
+
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Address of the management interface with the netmask, e.g. 192.168.1.245/24",
+				ValidateFunc: validateManagementIp,
+			},
+
+			"allow_address_change": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Confirm that the management address may change, the provider loses its connection when it connects through that address",
+			},
+		},
+	}
+}
+
+func resourceBigipSysManagementIpCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	address := d.Get("address").(string)
+	log.Println("[INFO] Creating management IP " + address)
+
+	old, err := currentManagementIp(client, address)
+	if err == nil && old != address {
+		err = changeManagementIp(client, old, address)
+	}
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create management IP (%s) (%v) ", address, err)
+		return err
+	}
+	d.SetId(address)
+
+	// The BIG-IP may not be reachable at the configured provider address anymore
+	if old != address {
+		return nil
+	}
+	return resourceBigipSysManagementIpRead(d, meta)
+}
+
+func resourceBigipSysManagementIpRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	address := d.Id()
+	log.Println("[INFO] Reading management IP " + address)
+
+	ip, err := client.GetManagementIp(address)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve management IP (%s) (%v) ", address, err)
+		return err
+	}
+	if ip == nil {
+		log.Printf("[WARN] Management IP (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("address", ip.Name)
+
+	return nil
+}
+
+func resourceBigipSysManagementIpUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	if !d.HasChange("address") {
+		return nil
+	}
+	old := d.Id()
+	address := d.Get("address").(string)
+	log.Printf("[INFO] Changing management IP (%s) to (%s)", old, address)
+
+	err := changeManagementIp(client, old, address)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update management IP (%s) (%v) ", old, err)
+		return err
+	}
+	d.SetId(address)
+
+	// The BIG-IP may not be reachable at the configured provider address anymore
+	return nil
+}
+
+// The BIG-IP can't be managed without a management address, destroying the
+// resource only removes it from the state.
+func resourceBigipSysManagementIpDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] Management IP (%s) is kept on the BIG-IP, removing from state only", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+// customizeManagementIpDiff refuses to plan a change of the management address
+// unless allow_address_change is set: the provider connects through the
+// management address and can't reach the BIG-IP after the change.
+func customizeManagementIpDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("address") {
+		return nil
+	}
+	address := d.Get("address").(string)
+
+	old := ""
+	if d.Id() != "" {
+		o, _ := d.GetChange("address")
+		old = o.(string)
+	} else {
+		var err error
+		old, err = currentManagementIp(meta.(*bigip.BigIP), address)
+		if err != nil {
+			return err
+		}
+	}
+	if old == "" || old == address {
+		return nil
+	}
+
+	log.Printf("[WARN] Management IP changes from (%s) to (%s), the BIG-IP must be managed through the new address afterwards", old, address)
+	if !d.Get("allow_address_change").(bool) {
+		return fmt.Errorf("changing the management IP from %s to %s drops the connection of the provider and of all "+
+			"management sessions. Set allow_address_change = true to confirm and update the address of the provider "+
+			"afterwards", old, address)
+	}
+	return nil
+}
+
+// currentManagementIp returns the management address of the same address
+// family as <address>, or "" when there is none.
+func currentManagementIp(client *bigip.BigIP, address string) (string, error) {
+	ips, err := client.ManagementIps()
+	if err != nil {
+		return "", err
+	}
+	v6 := strings.Contains(address, ":")
+	for _, ip := range ips.ManagementIps {
+		if strings.Contains(ip.Name, ":") == v6 {
+			return ip.Name, nil
+		}
+	}
+	return "", nil
+}
+
+func changeManagementIp(client *bigip.BigIP, old, address string) error {
+	var err error
+	if old == "" {
+		err = client.CreateManagementIp(address)
+	} else {
+		err = client.ReplaceManagementIp(old, address)
+	}
+	// The connection drops when the BIG-IP switches the address
+	if _, ok := err.(*url.Error); ok {
+		log.Printf("[WARN] Change of management IP to (%s) not confirmed (%v) ", address, err)
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,68 @@
+package bigip
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// The address must be the current management address of the BIG-IP under
+// test, the test doesn't change it.
+var TEST_MANAGEMENT_IP = os.Getenv("TEST_MANAGEMENT_IP")
+
+var TEST_MANAGEMENT_IP_RESOURCE = `
+resource "bigip_sys_management_ip" "test-management-ip" {
+	address = "` + TEST_MANAGEMENT_IP + `"
+}
+`
+
+var TEST_MANAGEMENT_IP_CHANGE_RESOURCE = `
+resource "bigip_sys_management_ip" "test-management-ip" {
+	address = "192.0.2.10/24"
+}
+`
+
+func TestAccBigipSysManagementIp_create(t *testing.T) {
+	if TEST_MANAGEMENT_IP == "" {
+		t.Skip("TEST_MANAGEMENT_IP not set")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_MANAGEMENT_IP_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckManagementIpExists(TEST_MANAGEMENT_IP),
+					resource.TestCheckResourceAttr("bigip_sys_management_ip.test-management-ip", "address", TEST_MANAGEMENT_IP),
+				),
+			},
+			{
+				Config:      TEST_MANAGEMENT_IP_CHANGE_RESOURCE,
+				ExpectError: regexp.MustCompile("Set allow_address_change = true to confirm"),
+			},
+		},
+	})
+}
+
+func testCheckManagementIpExists(address string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		ip, err := client.GetManagementIp(address)
+		if err != nil {
+			return err
+		}
+		if ip == nil {
+			return fmt.Errorf("Management IP %s does not exist.", address)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,160 @@
+package bigip
+
+import (
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSysManagementRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysManagementRouteCreate,
+		Read:   resourceBigipSysManagementRouteRead,
+		Update: resourceBigipSysManagementRouteUpdate,
+		Delete: resourceBigipSysManagementRouteDelete,
+		Exists: resourceBigipSysManagementRouteExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the management route, e.g. /Common/default",
+				ValidateFunc: validateF5Name,
+			},
+
+			"network": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Destination network with the netmask, e.g. 10.0.0.0/8, or default",
+			},
+
+			"gateway": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Gateway address on the management network",
+			},
+
+			"mtu": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "MTU of the route, 0 uses the MTU of the management interface",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+		},
+	}
+}
+
+func resourceBigipSysManagementRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating management route " + name)
+
+	r := dataToManagementRoute(d)
+	r.Name = name
+	r.Network = d.Get("network").(string)
+	err := client.CreateManagementRoute(r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create management route (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipSysManagementRouteRead(d, meta)
+}
+
+func resourceBigipSysManagementRouteRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading management route " + name)
+
+	route, err := client.GetManagementRoute(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve management route (%s) (%v) ", name, err)
+		return err
+	}
+	if route == nil {
+		log.Printf("[WARN] Management route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("network", route.Network)
+	d.Set("gateway", route.Gateway)
+	d.Set("mtu", route.Mtu)
+	d.Set("description", route.Description)
+
+	return nil
+}
+
+func resourceBigipSysManagementRouteExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if management route exists " + name)
+
+	route, err := client.GetManagementRoute(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve management route (%s) (%v) ", name, err)
+		return false, err
+	}
+	if route == nil {
+		log.Printf("[WARN] Management route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipSysManagementRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating management route " + name)
+
+	err := client.ModifyManagementRoute(name, dataToManagementRoute(d))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update management route (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipSysManagementRouteRead(d, meta)
+}
+
+func resourceBigipSysManagementRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting management route " + name)
+
+	err := client.DeleteManagementRoute(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete management route (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// The network of a route can't be modified, it is only set on create
+func dataToManagementRoute(d *schema.ResourceData) *bigip.ManagementRoute {
+	return &bigip.ManagementRoute{
+		Gateway:     d.Get("gateway").(string),
+		Mtu:         d.Get("mtu").(int),
+		Description: d.Get("description").(string),
+	}
+}
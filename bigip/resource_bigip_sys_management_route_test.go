@@ -0,0 +1,96 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_MANAGEMENT_ROUTE_NAME = "/Common/test-management-route"
+
+// The gateway must be on the management network of the BIG-IP under test
+var TEST_MANAGEMENT_ROUTE_GATEWAY = "10.192.74.1"
+
+var TEST_MANAGEMENT_ROUTE_RESOURCE = `
+resource "bigip_sys_management_route" "test-management-route" {
+	name        = "` + TEST_MANAGEMENT_ROUTE_NAME + `"
+	network     = "10.199.0.0/16"
+	gateway     = "` + TEST_MANAGEMENT_ROUTE_GATEWAY + `"
+	description = "test"
+}
+`
+
+func TestAccBigipSysManagementRoute_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckManagementRoutesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_MANAGEMENT_ROUTE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckManagementRouteExists(TEST_MANAGEMENT_ROUTE_NAME, true),
+					resource.TestCheckResourceAttr("bigip_sys_management_route.test-management-route", "network", "10.199.0.0/16"),
+					resource.TestCheckResourceAttr("bigip_sys_management_route.test-management-route", "gateway", TEST_MANAGEMENT_ROUTE_GATEWAY),
+					resource.TestCheckResourceAttr("bigip_sys_management_route.test-management-route", "description", "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysManagementRoute_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckManagementRoutesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_MANAGEMENT_ROUTE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckManagementRouteExists(TEST_MANAGEMENT_ROUTE_NAME, true),
+				),
+				ResourceName:      TEST_MANAGEMENT_ROUTE_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckManagementRouteExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		route, err := client.GetManagementRoute(name)
+		if err != nil {
+			return err
+		}
+		if exists && route == nil {
+			return fmt.Errorf("Management route %s was not created.", name)
+		}
+		if !exists && route != nil {
+			return fmt.Errorf("Management route %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckManagementRoutesDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_management_route" {
+			continue
+		}
+		if err := testCheckManagementRouteExists(rs.Primary.ID, false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSysPartition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysPartitionCreate,
+		Read:   resourceBigipSysPartitionRead,
+		Update: resourceBigipSysPartitionUpdate,
+		Delete: resourceBigipSysPartitionDelete,
+		Exists: resourceBigipSysPartitionExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the partition",
+				ValidateFunc: validatePartitionName,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description of the partition",
+			},
+
+			"default_route_domain": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Route domain used for addresses in the partition without a route domain",
+			},
+
+			"force_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the objects in the partition on destroy instead of failing",
+			},
+		},
+	}
+}
+
+func resourceBigipSysPartitionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating Partition " + name)
+
+	p := &bigip.Partition{
+		Name:               name,
+		Description:        d.Get("description").(string),
+		DefaultRouteDomain: d.Get("default_route_domain").(int),
+	}
+	err := client.CreatePartition(p)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Partition (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	return resourceBigipSysPartitionRead(d, meta)
+}
+
+func resourceBigipSysPartitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading Partition " + name)
+
+	p, err := client.GetPartition(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Partition (%s) (%v) ", name, err)
+		return err
+	}
+	if p == nil {
+		log.Printf("[WARN] Partition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("description", p.Description)
+	d.Set("default_route_domain", p.DefaultRouteDomain)
+
+	return nil
+}
+
+func resourceBigipSysPartitionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if Partition exists " + name)
+
+	p, err := client.GetPartition(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Partition (%s) (%v) ", name, err)
+		return false, err
+	}
+	if p == nil {
+		log.Printf("[WARN] Partition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipSysPartitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Updating Partition " + name)
+
+	p := &bigip.Partition{
+		Description:        d.Get("description").(string),
+		DefaultRouteDomain: d.Get("default_route_domain").(int),
+	}
+	err := client.ModifyPartition(name, p)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update Partition (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipSysPartitionRead(d, meta)
+}
+
+func resourceBigipSysPartitionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting Partition " + name)
+
+	objects, err := client.PartitionObjects(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Objects of Partition (%s) (%v) ", name, err)
+		return err
+	}
+	if len(objects) > 0 {
+		if !d.Get("force_destroy").(bool) {
+			return fmt.Errorf("Partition %s still contains objects, remove them or set force_destroy:\n%s",
+				name, strings.Join(objects, "\n"))
+		}
+		log.Printf("[WARN] Deleting %d objects in Partition (%s)", len(objects), name)
+		err = client.DeletePartitionObjects(name)
+		if err != nil {
+			log.Printf("[ERROR] Unable to Delete Objects of Partition (%s) (%v) ", name, err)
+			return err
+		}
+	}
+
+	err = client.DeletePartition(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Partition (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
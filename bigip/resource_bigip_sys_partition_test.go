@@ -0,0 +1,208 @@
+package bigip
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_SYS_PARTITION_NAME = "test-partition"
+
+var TEST_SYS_PARTITION_RESOURCE = `
+resource "bigip_sys_partition" "test-partition" {
+	name = "` + TEST_SYS_PARTITION_NAME + `"
+	description = "test partition"
+	default_route_domain = 0
+}
+`
+
+var TEST_SYS_PARTITION_FORCE_DESTROY_RESOURCE = `
+resource "bigip_sys_partition" "test-partition" {
+	name = "` + TEST_SYS_PARTITION_NAME + `"
+	force_destroy = true
+}
+
+resource "bigip_ltm_node" "test-node" {
+	name = "/${bigip_sys_partition.test-partition.name}/test-node"
+	address = "10.10.10.10"
+}
+`
+
+var TEST_SYS_PARTITION_GUARDED_RESOURCE = `
+resource "bigip_sys_partition" "test-partition" {
+	name = "` + TEST_SYS_PARTITION_NAME + `"
+}
+`
+
+func TestAccBigipSysPartition_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPartitionsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SYS_PARTITION_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPartitionExists(TEST_SYS_PARTITION_NAME, true),
+					resource.TestCheckResourceAttr("bigip_sys_partition.test-partition", "name", TEST_SYS_PARTITION_NAME),
+					resource.TestCheckResourceAttr("bigip_sys_partition.test-partition", "description", "test partition"),
+					resource.TestCheckResourceAttr("bigip_sys_partition.test-partition", "default_route_domain", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysPartition_forceDestroy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPartitionsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SYS_PARTITION_FORCE_DESTROY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPartitionExists(TEST_SYS_PARTITION_NAME, true),
+					resource.TestCheckResourceAttr("bigip_sys_partition.test-partition", "force_destroy", "true"),
+				),
+			},
+		},
+	})
+}
+
+// Objects created outside of Terraform, a monitor and profiles of which one
+// is derived from the other, are reported by the guard and removed by
+// force_destroy
+func TestAccBigipSysPartition_unmanagedObjects(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPartitionsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SYS_PARTITION_GUARDED_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPartitionExists(TEST_SYS_PARTITION_NAME, true),
+					testCreatePartitionObjects(TEST_SYS_PARTITION_NAME),
+					testCheckPartitionObjects(TEST_SYS_PARTITION_NAME, []string{
+						"ltm/monitor/http /" + TEST_SYS_PARTITION_NAME + "/test-monitor",
+						"ltm/profile/http /" + TEST_SYS_PARTITION_NAME + "/test-http",
+						"ltm/profile/http /" + TEST_SYS_PARTITION_NAME + "/test-http-child",
+					}),
+				),
+			},
+			{
+				Config:      TEST_SYS_PARTITION_GUARDED_RESOURCE,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("still contains objects"),
+			},
+			{
+				Config: TEST_SYS_PARTITION_FORCE_DESTROY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPartitionExists(TEST_SYS_PARTITION_NAME, true),
+				),
+			},
+		},
+	})
+}
+
+func testCreatePartitionObjects(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		err := client.CreateMonitor("/"+name+"/test-monitor", "http", "/Common/http", 5, 16, "", "", "")
+		if err == nil {
+			err = client.CreateHttpProfile("/"+name+"/test-http", "/Common/http")
+		}
+		if err == nil {
+			err = client.CreateHttpProfile("/"+name+"/test-http-child", "/"+name+"/test-http")
+		}
+		return err
+	}
+}
+
+func testCheckPartitionObjects(name string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		objects, err := client.PartitionObjects(name)
+		if err != nil {
+			return err
+		}
+		list := strings.Join(objects, "\n")
+		for _, e := range expected {
+			if !strings.Contains(list, e) {
+				return fmt.Errorf("%s not reported in partition %s:\n%s", e, name, list)
+			}
+		}
+		return nil
+	}
+}
+
+func TestAccBigipSysPartition_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckPartitionsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SYS_PARTITION_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckPartitionExists(TEST_SYS_PARTITION_NAME, true),
+				),
+				ResourceName:      TEST_SYS_PARTITION_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckPartitionExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		p, err := client.GetPartition(name)
+		if err != nil {
+			return err
+		}
+		if exists && p == nil {
+			return fmt.Errorf("partition %s was not created.", name)
+		}
+		if !exists && p != nil {
+			return fmt.Errorf("partition %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckPartitionsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_partition" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		p, err := client.GetPartition(name)
+		if err != nil {
+			return err
+		}
+		if p != nil {
+			return fmt.Errorf("partition %s not destroyed.", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,323 @@
+package bigip
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSysSoftware() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysSoftwareCreate,
+		Read:   resourceBigipSysSoftwareRead,
+		Update: resourceBigipSysSoftwareUpdate,
+		Delete: resourceBigipSysSoftwareDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"volume": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Boot volume to install to, e.g. HD1.2",
+				ValidateFunc: validateSoftwareVolume,
+			},
+
+			"image_path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Local path of the base image ISO",
+			},
+
+			"image_md5": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Expected MD5 of the base image, the image is verified before and after the upload",
+			},
+
+			"hotfix_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Local path of the hotfix ISO installed on top of the base image",
+			},
+
+			"hotfix_md5": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Expected MD5 of the hotfix, the hotfix is verified before and after the upload",
+			},
+
+			"reboot": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reboot into the volume once the software is installed",
+			},
+
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version installed on the volume",
+			},
+
+			"build": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Build installed on the volume",
+			},
+
+			"active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the BIG-IP runs from the volume",
+			},
+		},
+	}
+}
+
+func resourceBigipSysSoftwareCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	volume := d.Get("volume").(string)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	start := time.Now()
+	log.Println("[INFO] Installing software to volume " + volume)
+
+	image, sum, err := uploadSoftwareImage(client, d.Get("image_path").(string), d.Get("image_md5").(string), client.GetSoftwareImage, timeout)
+	if err == nil {
+		d.Set("image_md5", sum)
+		err = client.InstallSoftwareImage(image, volume)
+	}
+	if err == nil {
+		err = waitForSoftwareVolume(client, volume, timeout-time.Since(start))
+	}
+	if err != nil {
+		log.Printf("[ERROR] Unable to Install software to volume (%s) (%v) ", volume, err)
+		return err
+	}
+	d.SetId(volume)
+
+	if hotfixPath := d.Get("hotfix_path").(string); hotfixPath != "" {
+		hotfix, sum, err := uploadSoftwareImage(client, hotfixPath, d.Get("hotfix_md5").(string), client.GetSoftwareHotfix, timeout-time.Since(start))
+		if err == nil {
+			d.Set("hotfix_md5", sum)
+			err = client.InstallSoftwareHotfix(hotfix, volume)
+		}
+		if err == nil {
+			err = waitForSoftwareVolume(client, volume, timeout-time.Since(start))
+		}
+		if err != nil {
+			log.Printf("[ERROR] Unable to Install hotfix to volume (%s) (%v) ", volume, err)
+			return err
+		}
+	}
+
+	if d.Get("reboot").(bool) {
+		err = rebootSoftwareVolume(client, volume, timeout-time.Since(start))
+		if err != nil {
+			log.Printf("[ERROR] Unable to Reboot into volume (%s) (%v) ", volume, err)
+			return err
+		}
+	}
+
+	return resourceBigipSysSoftwareRead(d, meta)
+}
+
+func resourceBigipSysSoftwareRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	volume := d.Id()
+	log.Println("[INFO] Reading software volume " + volume)
+
+	v, err := client.GetSoftwareVolume(volume)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve software volume (%s) (%v) ", volume, err)
+		return err
+	}
+	if v == nil {
+		log.Printf("[WARN] Software volume (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("volume", volume)
+	d.Set("version", v.Version)
+	d.Set("build", v.Build)
+	d.Set("active", v.Active)
+
+	return nil
+}
+
+func resourceBigipSysSoftwareUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	volume := d.Id()
+	if d.HasChange("reboot") && d.Get("reboot").(bool) {
+		log.Println("[INFO] Rebooting into software volume " + volume)
+
+		err := rebootSoftwareVolume(client, volume, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			log.Printf("[ERROR] Unable to Reboot into volume (%s) (%v) ", volume, err)
+			return err
+		}
+	}
+
+	return resourceBigipSysSoftwareRead(d, meta)
+}
+
+// The active volume can't be removed, it is only removed from the state.
+func resourceBigipSysSoftwareDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	volume := d.Id()
+	log.Println("[INFO] Deleting software volume " + volume)
+
+	v, err := client.GetSoftwareVolume(volume)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve software volume (%s) (%v) ", volume, err)
+		return err
+	}
+	if v != nil && v.Active {
+		log.Printf("[WARN] Software volume (%s) is active, removing from state only", volume)
+	} else if v != nil {
+		err = client.DeleteSoftwareVolume(volume)
+		if err != nil {
+			log.Printf("[ERROR] Unable to Delete software volume (%s) (%v) ", volume, err)
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// uploadSoftwareImage verifies the MD5 of a local image, uploads it unless the
+// BIG-IP already has it and waits until the BIG-IP registered the image.
+// Returns the name and the MD5 of the image.
+func uploadSoftwareImage(client *bigip.BigIP, path, expected string, get func(string) (*bigip.SoftwareImage, error), timeout time.Duration) (string, string, error) {
+	name := filepath.Base(path)
+
+	f, err := os.Open(path)
+	if err != nil {
+		return "", "", err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return "", "", err
+	}
+
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", "", err
+	}
+	sum := fmt.Sprintf("%x", h.Sum(nil))
+	if expected != "" && !strings.EqualFold(expected, sum) {
+		return "", "", fmt.Errorf("MD5 of %s is %s, expected %s", path, sum, expected)
+	}
+
+	image, err := get(name)
+	if err != nil {
+		return "", "", err
+	}
+	if image == nil || !softwareChecksumMatches(image, sum) {
+		log.Println("[INFO] Uploading software image " + name)
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return "", "", err
+		}
+		if _, err := client.UploadImage(f, info.Size(), name); err != nil {
+			return "", "", err
+		}
+	}
+
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		image, err := get(name)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if image == nil || image.Version == "" {
+			return resource.RetryableError(fmt.Errorf("software image %s not yet registered", name))
+		}
+		if !softwareChecksumMatches(image, sum) {
+			return resource.NonRetryableError(fmt.Errorf("MD5 of the uploaded %s is %s, expected %s", name, image.Checksum, sum))
+		}
+		return nil
+	})
+	return name, sum, err
+}
+
+// The BIG-IP may not have computed the checksum of an image yet
+func softwareChecksumMatches(image *bigip.SoftwareImage, sum string) bool {
+	return image.Checksum == "" || strings.EqualFold(image.Checksum, sum)
+}
+
+// waitForSoftwareVolume polls the volume until the installation completed.
+func waitForSoftwareVolume(client *bigip.BigIP, volume string, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		v, err := client.GetSoftwareVolume(volume)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if v == nil || v.Installing() {
+			return resource.RetryableError(fmt.Errorf("software volume %s is installing", volume))
+		}
+		if v.Failed() {
+			return resource.NonRetryableError(fmt.Errorf("installation to volume %s %s", volume, v.Status))
+		}
+		if v.Status != bigip.VolumeComplete {
+			return resource.RetryableError(fmt.Errorf("software volume %s is %s", volume, v.Status))
+		}
+		return nil
+	})
+}
+
+// rebootSoftwareVolume reboots into the volume and waits until the BIG-IP runs
+// from it and is ready. Requests fail while the BIG-IP reboots, they are
+// retried after authenticating again.
+func rebootSoftwareVolume(client *bigip.BigIP, volume string, timeout time.Duration) error {
+	// The connection may drop before the reboot is confirmed
+	if err := client.RebootVolume(volume); err != nil {
+		log.Printf("[WARN] Reboot into volume (%s) not confirmed (%v) ", volume, err)
+	}
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		v, err := client.GetSoftwareVolume(volume)
+		if err != nil {
+			if err := client.RefreshTokenSession(); err != nil {
+				log.Printf("[DEBUG] Unable to authenticate while waiting for volume %s (%v) ", volume, err)
+			}
+			return resource.RetryableError(fmt.Errorf("BIG-IP unavailable: %s", err))
+		}
+		if v == nil || !v.Active {
+			return resource.RetryableError(fmt.Errorf("BIG-IP not yet running from volume %s", volume))
+		}
+
+		ready, err := client.SysReady()
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+		if !ready.ConfigReady || !ready.LicenseReady || !ready.ProvisionReady {
+			return resource.RetryableError(fmt.Errorf("BIG-IP not ready: config %t, license %t, provision %t",
+				ready.ConfigReady, ready.LicenseReady, ready.ProvisionReady))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,99 @@
+package bigip
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+var TEST_SOFTWARE_VOLUME = "HD1.3"
+
+// The image takes a long time to upload and install, the test only runs when
+// TEST_SOFTWARE_IMAGE_PATH points to an ISO.
+var TEST_SOFTWARE_RESOURCE = `
+resource "bigip_sys_software" "test-software" {
+	volume     = "` + TEST_SOFTWARE_VOLUME + `"
+	image_path = "` + os.Getenv("TEST_SOFTWARE_IMAGE_PATH") + `"
+}
+`
+
+func TestAccBigipSysSoftware_create(t *testing.T) {
+	if os.Getenv("TEST_SOFTWARE_IMAGE_PATH") == "" {
+		t.Skip("TEST_SOFTWARE_IMAGE_PATH not set")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckSoftwaresDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SOFTWARE_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSoftwareExists(TEST_SOFTWARE_VOLUME, true),
+					resource.TestCheckResourceAttr("bigip_sys_software.test-software", "active", "false"),
+					resource.TestCheckResourceAttrSet("bigip_sys_software.test-software", "version"),
+					resource.TestCheckResourceAttrSet("bigip_sys_software.test-software", "image_md5"),
+				),
+			},
+		},
+	})
+}
+
+func TestUploadSoftwareImageChecksum(t *testing.T) {
+	f, err := ioutil.TempFile("", "image")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("hello")
+	f.Close()
+
+	// The local image is verified before the BIG-IP is contacted
+	_, _, err = uploadSoftwareImage(nil, f.Name(), "00000000000000000000000000000000", nil, 0)
+	assert.EqualError(t, err, fmt.Sprintf("MD5 of %s is 5d41402abc4b2a76b9719d911017c592, expected 00000000000000000000000000000000", f.Name()))
+
+	image := &bigip.SoftwareImage{}
+	assert.True(t, softwareChecksumMatches(image, "5d41402abc4b2a76b9719d911017c592"))
+	image.Checksum = "5D41402ABC4B2A76B9719D911017C592"
+	assert.True(t, softwareChecksumMatches(image, "5d41402abc4b2a76b9719d911017c592"))
+	image.Checksum = "00000000000000000000000000000000"
+	assert.False(t, softwareChecksumMatches(image, "5d41402abc4b2a76b9719d911017c592"))
+}
+
+func testCheckSoftwareExists(volume string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		v, err := client.GetSoftwareVolume(volume)
+		if err != nil {
+			return err
+		}
+		if exists && v == nil {
+			return fmt.Errorf("Software volume %s was not created.", volume)
+		}
+		if !exists && v != nil {
+			return fmt.Errorf("Software volume %s still exists.", volume)
+		}
+		return nil
+	}
+}
+
+func testCheckSoftwaresDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_software" {
+			continue
+		}
+		if err := testCheckSoftwareExists(rs.Primary.ID, false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var syslogLevels = []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}
+
+func resourceBigipSysSyslog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysSyslogCreate,
+		Update: resourceBigipSysSyslogUpdate,
+		Read:   resourceBigipSysSyslogRead,
+		Delete: resourceBigipSysSyslogDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"remote_servers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Remote syslog servers the BIG-IP forwards its logs to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Name of the remote syslog server",
+							ValidateFunc: validateF5Name,
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "IP address or hostname of the remote syslog server",
+						},
+						"remote_port": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     514,
+							Description: "Port the remote syslog server listens on",
+						},
+						"local_ip": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "none",
+							Description: "Local IP address used as the source of the syslog messages",
+						},
+					},
+				},
+			},
+
+			"auth_priv_from": syslogLevelSchema("Lowest level of messages about user authentication to include in the system log"),
+			"auth_priv_to":   syslogLevelSchema("Highest level of messages about user authentication to include in the system log"),
+			"cron_from":      syslogLevelSchema("Lowest level of messages about time-based scheduling to include in the system log"),
+			"cron_to":        syslogLevelSchema("Highest level of messages about time-based scheduling to include in the system log"),
+			"daemon_from":    syslogLevelSchema("Lowest level of messages about daemon performance to include in the system log"),
+			"daemon_to":      syslogLevelSchema("Highest level of messages about daemon performance to include in the system log"),
+			"kern_from":      syslogLevelSchema("Lowest level of kernel messages to include in the system log"),
+			"kern_to":        syslogLevelSchema("Highest level of kernel messages to include in the system log"),
+			"mail_from":      syslogLevelSchema("Lowest level of mail log messages to include in the system log"),
+			"mail_to":        syslogLevelSchema("Highest level of mail log messages to include in the system log"),
+			"messages_from":  syslogLevelSchema("Lowest level of system messages to include in the system log"),
+			"messages_to":    syslogLevelSchema("Highest level of system messages to include in the system log"),
+			"user_log_from":  syslogLevelSchema("Lowest level of user account messages to include in the system log"),
+			"user_log_to":    syslogLevelSchema("Highest level of user account messages to include in the system log"),
+
+			"include": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Syslog-ng configuration text appended to the system syslog configuration",
+			},
+		},
+	}
+}
+
+func syslogLevelSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  description,
+		ValidateFunc: validateStringValue(syslogLevels),
+	}
+}
+
+func resourceBigipSysSyslogCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Creating Syslog")
+
+	r := dataToSyslog(d)
+	err := client.CreateSyslog(&r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Syslog (%v) ", err)
+		return err
+	}
+	d.SetId("syslog")
+
+	return resourceBigipSysSyslogRead(d, meta)
+}
+
+func resourceBigipSysSyslogUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Updating Syslog")
+
+	r := dataToSyslog(d)
+	err := client.ModifySyslog(&r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify Syslog (%v) ", err)
+		return err
+	}
+	return resourceBigipSysSyslogRead(d, meta)
+}
+
+func resourceBigipSysSyslogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Reading Syslog")
+
+	syslog, err := client.Syslogs()
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Syslog (%v) ", err)
+		return err
+	}
+	if syslog == nil {
+		log.Printf("[WARN] Syslog (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Every server on the device is written back so that servers added
+	// outside of Terraform show up as a diff.
+	var servers []map[string]interface{}
+	for _, s := range syslog.RemoteServers {
+		servers = append(servers, map[string]interface{}{
+			"name":        s.Name,
+			"host":        s.Host,
+			"remote_port": s.RemotePort,
+			"local_ip":    s.LocalIp,
+		})
+	}
+	if err := d.Set("remote_servers", servers); err != nil {
+		return fmt.Errorf("[DEBUG] Error saving Remote Servers to state for Syslog (%s): %s", d.Id(), err)
+	}
+
+	d.Set("auth_priv_from", syslog.AuthPrivFrom)
+	d.Set("auth_priv_to", syslog.AuthPrivTo)
+	d.Set("cron_from", syslog.CronFrom)
+	d.Set("cron_to", syslog.CronTo)
+	d.Set("daemon_from", syslog.DaemonFrom)
+	d.Set("daemon_to", syslog.DaemonTo)
+	d.Set("kern_from", syslog.KernFrom)
+	d.Set("kern_to", syslog.KernTo)
+	d.Set("mail_from", syslog.MailFrom)
+	d.Set("mail_to", syslog.MailTo)
+	d.Set("messages_from", syslog.MessagesFrom)
+	d.Set("messages_to", syslog.MessagesTo)
+	d.Set("user_log_from", syslog.UserLogFrom)
+	d.Set("user_log_to", syslog.UserLogTo)
+	if syslog.Include == "none" {
+		d.Set("include", "")
+	} else {
+		d.Set("include", syslog.Include)
+	}
+
+	return nil
+}
+
+func resourceBigipSysSyslogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	// There is no Delete API for syslog, so the remote servers and the
+	// include text are cleared and the log levels are left as they are.
+	log.Println("[INFO] Removing Syslog remote servers")
+
+	r := &bigip.Syslog{
+		Include: "none",
+	}
+	err := client.ModifySyslog(r)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Remove Syslog remote servers (%v) ", err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func dataToSyslog(d *schema.ResourceData) bigip.Syslog {
+	var r bigip.Syslog
+
+	r.AuthPrivFrom = d.Get("auth_priv_from").(string)
+	r.AuthPrivTo = d.Get("auth_priv_to").(string)
+	r.CronFrom = d.Get("cron_from").(string)
+	r.CronTo = d.Get("cron_to").(string)
+	r.DaemonFrom = d.Get("daemon_from").(string)
+	r.DaemonTo = d.Get("daemon_to").(string)
+	r.KernFrom = d.Get("kern_from").(string)
+	r.KernTo = d.Get("kern_to").(string)
+	r.MailFrom = d.Get("mail_from").(string)
+	r.MailTo = d.Get("mail_to").(string)
+	r.MessagesFrom = d.Get("messages_from").(string)
+	r.MessagesTo = d.Get("messages_to").(string)
+	r.UserLogFrom = d.Get("user_log_from").(string)
+	r.UserLogTo = d.Get("user_log_to").(string)
+
+	r.Include = d.Get("include").(string)
+	if r.Include == "" {
+		r.Include = "none"
+	}
+
+	rs := d.Get("remote_servers").(*schema.Set)
+	r.RemoteServers = make([]bigip.RemoteServer, 0, rs.Len())
+	for _, s := range rs.List() {
+		server := s.(map[string]interface{})
+		r.RemoteServers = append(r.RemoteServers, bigip.RemoteServer{
+			Name:       server["name"].(string),
+			Host:       server["host"].(string),
+			RemotePort: server["remote_port"].(int),
+			LocalIp:    server["local_ip"].(string),
+		})
+	}
+
+	return r
+}
@@ -0,0 +1,111 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_SYSLOG_SERVER_NAME = fmt.Sprintf("/%s/test-syslog", TEST_PARTITION)
+
+var TEST_SYSLOG_RESOURCE = `
+resource "bigip_sys_syslog" "test-syslog" {
+	remote_servers {
+		name = "` + TEST_SYSLOG_SERVER_NAME + `"
+		host = "10.10.10.100"
+		remote_port = 514
+	}
+	auth_priv_from = "notice"
+	include = "filter f_remote { facility(local0); };"
+}
+`
+
+func TestAccBigipSysSyslog_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckSyslogServersDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SYSLOG_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSyslogServerExists(TEST_SYSLOG_SERVER_NAME, true),
+					resource.TestCheckResourceAttr("bigip_sys_syslog.test-syslog", "remote_servers.#", "1"),
+					resource.TestCheckResourceAttr("bigip_sys_syslog.test-syslog", "auth_priv_from", "notice"),
+					resource.TestCheckResourceAttr("bigip_sys_syslog.test-syslog", "include", "filter f_remote { facility(local0); };"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysSyslog_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckSyslogServersDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_SYSLOG_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSyslogServerExists(TEST_SYSLOG_SERVER_NAME, true),
+				),
+			},
+			{
+				ResourceName:      "bigip_sys_syslog.test-syslog",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckSyslogServerExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		syslog, err := client.Syslogs()
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, server := range syslog.RemoteServers {
+			if server.Name == name {
+				found = true
+			}
+		}
+		if exists && !found {
+			return fmt.Errorf("syslog remote server %s was not created.", name)
+		}
+		if !exists && found {
+			return fmt.Errorf("syslog remote server %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckSyslogServersDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_syslog" {
+			continue
+		}
+
+		syslog, err := client.Syslogs()
+		if err != nil {
+			return err
+		}
+		if len(syslog.RemoteServers) != 0 {
+			return fmt.Errorf("syslog remote servers were not removed.")
+		}
+	}
+	return nil
+}
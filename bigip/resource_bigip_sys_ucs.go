@@ -0,0 +1,259 @@
+package bigip
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipSysUcs() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipSysUcsCreate,
+		Read:   resourceBigipSysUcsRead,
+		Delete: resourceBigipSysUcsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the UCS archive, e.g. pre-change.ucs",
+			},
+
+			"local_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Local path the archive is downloaded to, or uploaded from when restoring",
+			},
+
+			"restore": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Upload the archive at local_path and load it instead of saving the configuration",
+			},
+
+			"passphrase": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				ForceNew:    true,
+				Description: "Passphrase encrypting the archive",
+			},
+
+			"no_private_keys": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Exclude the private keys from the archive",
+			},
+
+			"no_license": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Keep the license of the BIG-IP when restoring",
+			},
+
+			"reset_trust": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Reset the device trust when restoring, e.g. to restore the archive of another device",
+			},
+
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values, changing them saves or restores the archive again",
+			},
+
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the saved archive in bytes",
+			},
+
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "BIG-IP version the archive was saved on",
+			},
+		},
+	}
+}
+
+func resourceBigipSysUcsCreate(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+
+	var err error
+	if d.Get("restore").(bool) {
+		log.Println("[INFO] Restoring UCS " + name)
+		err = restoreUcs(name, d, meta)
+	} else {
+		log.Println("[INFO] Saving UCS " + name)
+		err = saveUcs(name, d, meta)
+	}
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create UCS (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+
+	// A failed download taints the saved archive
+	if localPath := d.Get("local_path").(string); localPath != "" && !d.Get("restore").(bool) {
+		err = downloadUcs(name, localPath, meta)
+		if err != nil {
+			log.Printf("[ERROR] Unable to Download UCS (%s) (%v) ", name, err)
+			return err
+		}
+	}
+
+	return resourceBigipSysUcsRead(d, meta)
+}
+
+func resourceBigipSysUcsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	// A restored archive isn't kept on the BIG-IP
+	if d.Get("restore").(bool) {
+		return nil
+	}
+
+	name := d.Id()
+	log.Println("[INFO] Reading UCS " + name)
+
+	ucs, err := client.GetUcs(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve UCS (%s) (%v) ", name, err)
+		return err
+	}
+	if ucs == nil {
+		log.Printf("[WARN] UCS (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("size", ucs.Size())
+	d.Set("version", ucs.ApiRawValues.Version)
+
+	return nil
+}
+
+// The downloaded archive is kept, only the archive on the BIG-IP is removed.
+// Destroying a restore doesn't change the configuration of the BIG-IP.
+func resourceBigipSysUcsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	if !d.Get("restore").(bool) {
+		log.Println("[INFO] Deleting UCS " + name)
+
+		err := client.DeleteUcs(name)
+		if err != nil {
+			log.Printf("[ERROR] Unable to Delete UCS (%s) (%v) ", name, err)
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+func saveUcs(name string, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	task, err := client.SaveUcs(name, d.Get("passphrase").(string), d.Get("no_private_keys").(bool))
+	if err != nil {
+		return err
+	}
+	return waitForUcsTask(client, task.ID, d.Timeout(schema.TimeoutCreate), false)
+}
+
+func downloadUcs(name, localPath string, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	f, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return client.DownloadUcs(name, f)
+}
+
+func restoreUcs(name string, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	localPath := d.Get("local_path").(string)
+	if localPath == "" {
+		return fmt.Errorf("local_path is required to restore UCS %s", name)
+	}
+	f, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	path, err := client.Upload(f, info.Size(), bigip.UcsFileName(name))
+	if err != nil {
+		return err
+	}
+
+	task, err := client.LoadUcs(path, d.Get("passphrase").(string), d.Get("no_license").(bool), d.Get("reset_trust").(bool))
+	if err != nil {
+		return err
+	}
+	return waitForUcsTask(client, task.ID, d.Timeout(schema.TimeoutCreate), true)
+}
+
+// waitForUcsTask polls the UCS task until it completes. Loading an archive
+// restarts the services of the BIG-IP, failed requests are retried after
+// authenticating again and the BIG-IP must be ready once the task completed.
+func waitForUcsTask(client *bigip.BigIP, id string, timeout time.Duration, ready bool) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		task, err := client.GetUcsTask(id)
+		if err != nil {
+			if err := client.RefreshTokenSession(); err != nil {
+				log.Printf("[DEBUG] Unable to authenticate while waiting for UCS task %s (%v) ", id, err)
+			}
+			return resource.RetryableError(fmt.Errorf("UCS task %s unavailable: %s", id, err))
+		}
+		switch task.State {
+		case bigip.UcsTaskFailed:
+			return resource.NonRetryableError(fmt.Errorf("UCS task %s failed: %s", id, task.Message))
+		case bigip.UcsTaskCompleted:
+		default:
+			return resource.RetryableError(fmt.Errorf("UCS task %s is %s", id, task.State))
+		}
+		if !ready {
+			return nil
+		}
+
+		sys, err := client.SysReady()
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+		if !sys.ConfigReady || !sys.LicenseReady || !sys.ProvisionReady {
+			return resource.RetryableError(fmt.Errorf("BIG-IP not ready: config %t, license %t, provision %t",
+				sys.ConfigReady, sys.LicenseReady, sys.ProvisionReady))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,86 @@
+package bigip
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_UCS_NAME = "test-ucs.ucs"
+var TEST_UCS_PATH = filepath.Join(os.TempDir(), TEST_UCS_NAME)
+
+var TEST_UCS_RESOURCE = `
+resource "bigip_sys_ucs" "test-ucs" {
+	name            = "` + TEST_UCS_NAME + `"
+	local_path      = "` + filepath.ToSlash(TEST_UCS_PATH) + `"
+	no_private_keys = true
+}
+`
+
+func TestAccBigipSysUcs_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckUcssDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_UCS_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckUcsExists(TEST_UCS_NAME, true),
+					testCheckUcsDownloaded(TEST_UCS_PATH),
+				),
+			},
+		},
+	})
+}
+
+func testCheckUcsExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		ucs, err := client.GetUcs(name)
+		if err != nil {
+			return err
+		}
+		if exists && ucs == nil {
+			return fmt.Errorf("UCS %s was not created.", name)
+		}
+		if !exists && ucs != nil {
+			return fmt.Errorf("UCS %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckUcsDownloaded(path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("UCS was not downloaded: %s", err)
+		}
+		size := s.RootModule().Resources["bigip_sys_ucs.test-ucs"].Primary.Attributes["size"]
+		if fmt.Sprint(info.Size()) != size {
+			return fmt.Errorf("downloaded UCS has %d bytes, expected %s", info.Size(), size)
+		}
+		return nil
+	}
+}
+
+func testCheckUcssDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_ucs" {
+			continue
+		}
+		if err := testCheckUcsExists(rs.Primary.ID, false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
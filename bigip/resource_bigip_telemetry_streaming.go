@@ -0,0 +1,185 @@
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Telemetry Streaming holds a single declaration per BIG-IP
+const telemetryDeclaration = "telemetry"
+
+func resourceBigipTelemetryStreaming() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipTelemetryStreamingCreate,
+		Read:   resourceBigipTelemetryStreamingRead,
+		Update: resourceBigipTelemetryStreamingUpdate,
+		Delete: resourceBigipTelemetryStreamingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ts_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Telemetry Streaming declaration in JSON",
+				ValidateFunc:     validateTelemetryJson,
+				DiffSuppressFunc: suppressEquivalentJson,
+			},
+		},
+	}
+}
+
+func resourceBigipTelemetryStreamingCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Creating Telemetry Streaming declaration")
+
+	_, err := client.PostTelemetryDeclaration(json.RawMessage(d.Get("ts_json").(string)))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Telemetry Streaming declaration (%v) ", err)
+		return err
+	}
+	d.SetId(telemetryDeclaration)
+
+	return resourceBigipTelemetryStreamingRead(d, meta)
+}
+
+func resourceBigipTelemetryStreamingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Reading Telemetry Streaming declaration")
+
+	remote, err := client.GetTelemetryDeclaration()
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Telemetry Streaming declaration (%v) ", err)
+		return err
+	}
+	if len(telemetryComponents(remote)) == 0 {
+		log.Printf("[WARN] Telemetry Streaming declaration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	declaration, err := telemetryDrift(d.Get("ts_json").(string), remote)
+	if err != nil {
+		return fmt.Errorf("[DEBUG] Error comparing Telemetry Streaming declaration (%s): %s", d.Id(), err)
+	}
+	d.Set("ts_json", declaration)
+
+	return nil
+}
+
+func resourceBigipTelemetryStreamingUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Updating Telemetry Streaming declaration")
+
+	_, err := client.PostTelemetryDeclaration(json.RawMessage(d.Get("ts_json").(string)))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update Telemetry Streaming declaration (%v) ", err)
+		return err
+	}
+
+	return resourceBigipTelemetryStreamingRead(d, meta)
+}
+
+func resourceBigipTelemetryStreamingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	log.Println("[INFO] Deleting Telemetry Streaming declaration")
+
+	err := client.DeleteTelemetryDeclaration()
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Telemetry Streaming declaration (%v) ", err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// telemetryComponents returns the names of the pollers, listeners, consumers
+// and other components of the declaration.
+func telemetryComponents(declaration map[string]interface{}) []string {
+	var components []string
+	for k, v := range declaration {
+		if _, ok := v.(map[string]interface{}); ok && k != "controls" {
+			components = append(components, k)
+		}
+	}
+	return components
+}
+
+// telemetryDrift returns the declaration as it is deployed on the BIG-IP.
+// Only the properties present in the configured declaration are compared, so
+// defaults added by Telemetry Streaming don't show up as changes. Secrets are
+// returned encrypted and can't be compared, the configured ones are kept.
+// Without a configured declaration (e.g. on import) the deployed declaration
+// is returned.
+func telemetryDrift(configured string, remote map[string]interface{}) (string, error) {
+	if configured == "" {
+		b, err := json.Marshal(remote)
+		return string(b), err
+	}
+
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(configured), &declaration); err != nil {
+		return "", err
+	}
+
+	deployed := telemetrySecrets(remote, declaration)
+	deployed = as3Filter(deployed, declaration)
+	if reflect.DeepEqual(deployed, declaration) {
+		return configured, nil
+	}
+	b, err := json.Marshal(deployed)
+	return string(b), err
+}
+
+// telemetrySecrets replaces the encrypted secrets of the deployed value with
+// the configured ones.
+func telemetrySecrets(deployed, configured interface{}) interface{} {
+	switch d := deployed.(type) {
+	case map[string]interface{}:
+		c, ok := configured.(map[string]interface{})
+		if !ok {
+			return deployed
+		}
+		if _, secret := d["protected"]; secret {
+			return configured
+		}
+		replaced := make(map[string]interface{}, len(d))
+		for k, v := range d {
+			replaced[k] = telemetrySecrets(v, c[k])
+		}
+		return replaced
+	case []interface{}:
+		c, ok := configured.([]interface{})
+		if !ok || len(c) != len(d) {
+			return deployed
+		}
+		replaced := make([]interface{}, len(d))
+		for i := range d {
+			replaced[i] = telemetrySecrets(d[i], c[i])
+		}
+		return replaced
+	}
+	return deployed
+}
+
+func validateTelemetryJson(value interface{}, field string) (ws []string, errors []error) {
+	var declaration map[string]interface{}
+	if err := json.Unmarshal([]byte(value.(string)), &declaration); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", field, err))
+		return
+	}
+	if declaration["class"] != "Telemetry" {
+		errors = append(errors, fmt.Errorf("%q must be a declaration of class Telemetry", field))
+	}
+	return
+}
@@ -0,0 +1,142 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_TELEMETRY_RESOURCE = `
+resource "bigip_telemetry_streaming" "test-ts" {
+	ts_json = <<EOF
+{
+	"class": "Telemetry",
+	"test_poller": {
+		"class": "Telemetry_System",
+		"systemPoller": {
+			"interval": 60
+		}
+	},
+	"test_listener": {
+		"class": "Telemetry_Listener",
+		"port": 6514
+	},
+	"test_consumer": {
+		"class": "Telemetry_Consumer",
+		"type": "Generic_HTTP",
+		"host": "192.0.2.10",
+		"protocol": "https",
+		"port": 443,
+		"path": "/telemetry",
+		"method": "POST"
+	}
+}
+EOF
+}
+`
+
+func TestAccBigipTelemetryStreaming_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTelemetryDeclarationDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_TELEMETRY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTelemetryComponentExists("test_consumer", true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipTelemetryStreaming_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTelemetryDeclarationDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_TELEMETRY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTelemetryComponentExists("test_consumer", true),
+				),
+				ResourceName:      "bigip_telemetry_streaming.test-ts",
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestTelemetryDrift(t *testing.T) {
+	configured := `{"class":"Telemetry","consumer":{"class":"Telemetry_Consumer","type":"Splunk","host":"192.0.2.1","passphrase":{"cipherText":"secret"}}}`
+	consumer := map[string]interface{}{
+		"class":      "Telemetry_Consumer",
+		"type":       "Splunk",
+		"host":       "192.0.2.1",
+		"protocol":   "https",
+		"passphrase": map[string]interface{}{"class": "Secret", "protected": "SecureVault", "cipherText": "$M$Zq$abc"},
+	}
+	remote := map[string]interface{}{
+		"class":         "Telemetry",
+		"schemaVersion": "1.0.0",
+		"consumer":      consumer,
+	}
+
+	declaration, err := telemetryDrift(configured, remote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if declaration != configured {
+		t.Errorf("defaults and encrypted secrets should be ignored, got %s", declaration)
+	}
+
+	consumer["host"] = "192.0.2.2"
+	declaration, err = telemetryDrift(configured, remote)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if suppressEquivalentJson("", configured, declaration, nil) {
+		t.Errorf("changed host should be detected, got %s", declaration)
+	}
+}
+
+func testCheckTelemetryComponentExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		declaration, err := client.GetTelemetryDeclaration()
+		if err != nil {
+			return err
+		}
+		_, ok := declaration[name]
+		if exists && !ok {
+			return fmt.Errorf("telemetry component %s was not created.", name)
+		}
+		if !exists && ok {
+			return fmt.Errorf("telemetry component %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckTelemetryDeclarationDestroyed(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_telemetry_streaming" {
+			continue
+		}
+		if err := testCheckTelemetryComponentExists("test_consumer", false)(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,292 @@
+package bigip
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigipWafPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigipWafPolicyCreate,
+		Read:   resourceBigipWafPolicyRead,
+		Update: resourceBigipWafPolicyUpdate,
+		Delete: resourceBigipWafPolicyDelete,
+		Exists: resourceBigipWafPolicyExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the policy, e.g. /Common/my-waf-policy",
+				ValidateFunc: validateF5Name,
+			},
+
+			"policy": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Exported XML or JSON policy, e.g. loaded with file()",
+				StateFunc:   wafPolicyHash,
+			},
+
+			"apply": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Apply the policy after the import so that it is enforced",
+			},
+
+			"policy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the policy in ASM",
+			},
+
+			"full_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path of the policy, e.g. for the asm action of bigip_ltm_policy",
+			},
+
+			"export_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Hash of the policy exported from the BIG-IP, changes outside of Terraform change the hash",
+			},
+		},
+	}
+}
+
+func resourceBigipWafPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating WAF policy " + name)
+
+	policy, err := importWafPolicy(client, d, "", d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create WAF policy (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId(name)
+	d.Set("policy_id", policy.ID)
+
+	return resourceBigipWafPolicyRead(d, meta)
+}
+
+func resourceBigipWafPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Reading WAF policy " + name)
+
+	policy, err := client.GetWafPolicy(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve WAF policy (%s) (%v) ", name, err)
+		return err
+	}
+	if policy == nil {
+		log.Printf("[WARN] WAF policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	hash, err := exportWafPolicyHash(client, policy.ID, d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Export WAF policy (%s) (%v) ", name, err)
+		return err
+	}
+	// The imported policy can't be compared with the export, a changed export
+	// means the policy was changed outside of Terraform and is imported again
+	if old := d.Get("export_hash").(string); old != "" && old != hash {
+		log.Printf("[WARN] WAF policy (%s) changed on the BIG-IP, export hash %s instead of %s", name, hash, old)
+		d.Set("policy", "")
+	}
+
+	d.Set("name", name)
+	d.Set("policy_id", policy.ID)
+	d.Set("full_path", policy.FullPath)
+	d.Set("export_hash", hash)
+
+	return nil
+}
+
+func resourceBigipWafPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Checking if WAF policy exists " + name)
+
+	policy, err := client.GetWafPolicy(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve WAF policy (%s) (%v) ", name, err)
+		return false, err
+	}
+	if policy == nil {
+		log.Printf("[WARN] WAF policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return false, nil
+	}
+	return true, nil
+}
+
+func resourceBigipWafPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	log.Println("[INFO] Updating WAF policy " + name)
+
+	var err error
+	if d.HasChange("policy") {
+		_, err = importWafPolicy(client, d, d.Get("policy_id").(string), timeout)
+	} else if d.HasChange("apply") && d.Get("apply").(bool) {
+		id := d.Get("policy_id").(string)
+		_, err = runAsmTask(client, bigip.AsmApplyPolicy, func() (*bigip.AsmTask, error) { return client.ApplyWafPolicy(id) }, timeout)
+		if err == nil {
+			err = setWafPolicyExportHash(client, d, id, timeout)
+		}
+	}
+	if err != nil {
+		log.Printf("[ERROR] Unable to Update WAF policy (%s) (%v) ", name, err)
+		return err
+	}
+
+	return resourceBigipWafPolicyRead(d, meta)
+}
+
+func resourceBigipWafPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting WAF policy " + name)
+
+	err := client.DeleteWafPolicy(d.Get("policy_id").(string))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete WAF policy (%s) (%v) ", name, err)
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// importWafPolicy uploads and imports the configured policy, replacing the
+// policy <id> when set, and applies it. The hash of the resulting export is
+// stored so that Read doesn't take the import for a change on the BIG-IP.
+func importWafPolicy(client *bigip.BigIP, d *schema.ResourceData, id string, timeout time.Duration) (*bigip.WafPolicy, error) {
+	start := time.Now()
+	name := d.Get("name").(string)
+	content := d.Get("policy").(string)
+
+	filename := wafPolicyFilename(name, content)
+	if err := client.UploadAsmFile([]byte(content), filename); err != nil {
+		return nil, err
+	}
+	_, err := runAsmTask(client, bigip.AsmImportPolicy, func() (*bigip.AsmTask, error) { return client.ImportWafPolicy(filename, name, id) }, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := client.GetWafPolicy(name)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return nil, fmt.Errorf("WAF policy %s not found after the import", name)
+	}
+
+	if d.Get("apply").(bool) {
+		_, err = runAsmTask(client, bigip.AsmApplyPolicy, func() (*bigip.AsmTask, error) { return client.ApplyWafPolicy(policy.ID) }, timeout-time.Since(start))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return policy, setWafPolicyExportHash(client, d, policy.ID, timeout-time.Since(start))
+}
+
+func setWafPolicyExportHash(client *bigip.BigIP, d *schema.ResourceData, id string, timeout time.Duration) error {
+	hash, err := exportWafPolicyHash(client, id, timeout)
+	if err != nil {
+		return err
+	}
+	d.Set("export_hash", hash)
+	return nil
+}
+
+func exportWafPolicyHash(client *bigip.BigIP, id string, timeout time.Duration) (string, error) {
+	export, err := runAsmTask(client, bigip.AsmExportPolicy, func() (*bigip.AsmTask, error) { return client.ExportWafPolicy(id) }, timeout)
+	if err != nil {
+		return "", err
+	}
+	return wafPolicyHash(export.Result.File), nil
+}
+
+// runAsmTask starts a task and polls it until it completed, returns the
+// completed task.
+func runAsmTask(client *bigip.BigIP, kind string, start func() (*bigip.AsmTask, error), timeout time.Duration) (*bigip.AsmTask, error) {
+	task, err := start()
+	if err != nil {
+		return nil, err
+	}
+	var done *bigip.AsmTask
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		t, err := waitForAsmTask(client, kind, task)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if t == nil {
+			return resource.RetryableError(fmt.Errorf("ASM task %s %s is running", kind, task.ID))
+		}
+		done = t
+		return nil
+	})
+	return done, err
+}
+
+// waitForAsmTask polls the task once, returns nil while the task is running.
+func waitForAsmTask(client *bigip.BigIP, kind string, task *bigip.AsmTask) (*bigip.AsmTask, error) {
+	t, err := client.GetAsmTask(kind, task.ID)
+	if err != nil {
+		return nil, err
+	}
+	switch t.Status {
+	case bigip.AsmTaskCompleted:
+		return t, nil
+	case bigip.AsmTaskFailed:
+		return nil, fmt.Errorf("ASM task %s %s failed: %s", kind, task.ID, t.Result.Message)
+	}
+	return nil, nil
+}
+
+// wafPolicyFilename names the upload after the policy, ASM detects the format
+// of the policy from the extension.
+func wafPolicyFilename(name, content string) string {
+	ext := ".xml"
+	if strings.HasPrefix(strings.TrimSpace(content), "{") {
+		ext = ".json"
+	}
+	return strings.Replace(strings.TrimPrefix(name, "/"), "/", "_", -1) + ext
+}
+
+func wafPolicyHash(v interface{}) string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(s)))
+}
@@ -0,0 +1,155 @@
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+var TEST_WAF_POLICY_NAME = fmt.Sprintf("/%s/test-waf-policy", TEST_PARTITION)
+
+var TEST_WAF_POLICY_RESOURCE = testWafPolicyResource("transparent")
+
+func testWafPolicyResource(enforcementMode string) string {
+	return `
+resource "bigip_waf_policy" "test-waf-policy" {
+	name = "` + TEST_WAF_POLICY_NAME + `"
+	policy = <<EOF
+{
+  "policy": {
+    "name": "test-waf-policy",
+    "template": { "name": "POLICY_TEMPLATE_RAPID_DEPLOYMENT" },
+    "enforcementMode": "` + enforcementMode + `"
+  }
+}
+EOF
+}
+`
+}
+
+func TestAccBigipWafPolicy_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckWafPoliciesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_WAF_POLICY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckWafPolicyExists(TEST_WAF_POLICY_NAME, true),
+					testCheckWafPolicyEnforcementMode(TEST_WAF_POLICY_NAME, "transparent"),
+					resource.TestCheckResourceAttr("bigip_waf_policy.test-waf-policy", "full_path", TEST_WAF_POLICY_NAME),
+					resource.TestCheckResourceAttrSet("bigip_waf_policy.test-waf-policy", "policy_id"),
+					resource.TestCheckResourceAttrSet("bigip_waf_policy.test-waf-policy", "export_hash"),
+				),
+			},
+			{
+				Config: testWafPolicyResource("blocking"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckWafPolicyEnforcementMode(TEST_WAF_POLICY_NAME, "blocking"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipWafPolicy_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckWafPoliciesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TEST_WAF_POLICY_RESOURCE,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckWafPolicyExists(TEST_WAF_POLICY_NAME, true),
+				),
+				ResourceName:      TEST_WAF_POLICY_NAME,
+				ImportState:       false,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestWafPolicyFilename(t *testing.T) {
+	if name := wafPolicyFilename("/Common/my-policy", "  {\"policy\": {}}"); name != "Common_my-policy.json" {
+		t.Errorf("unexpected file name %s for a JSON policy", name)
+	}
+	if name := wafPolicyFilename("/Common/my-policy", "<?xml version=\"1.0\"?><policy/>"); name != "Common_my-policy.xml" {
+		t.Errorf("unexpected file name %s for an XML policy", name)
+	}
+}
+
+func TestWafPolicyHash(t *testing.T) {
+	if hash := wafPolicyHash(""); hash != "" {
+		t.Errorf("expected no hash of an empty policy, got %s", hash)
+	}
+	if wafPolicyHash("<policy/>") != wafPolicyHash("<policy/>") {
+		t.Errorf("hash of the same policy differs")
+	}
+	if wafPolicyHash("<policy/>") == wafPolicyHash("<policy></policy>") {
+		t.Errorf("hash of different policies is the same")
+	}
+}
+
+func testCheckWafPolicyExists(name string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		policy, err := client.GetWafPolicy(name)
+		if err != nil {
+			return err
+		}
+		if exists && policy == nil {
+			return fmt.Errorf("WAF policy %s was not created.", name)
+		}
+		if !exists && policy != nil {
+			return fmt.Errorf("WAF policy %s still exists.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckWafPolicyEnforcementMode(name, enforcementMode string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		policy, err := client.GetWafPolicy(name)
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			return fmt.Errorf("WAF policy %s was not created.", name)
+		}
+		if policy.EnforcementMode != enforcementMode {
+			return fmt.Errorf("WAF policy %s is in %s mode, expected %s.", name, policy.EnforcementMode, enforcementMode)
+		}
+		return nil
+	}
+}
+
+func testCheckWafPoliciesDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_waf_policy" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		policy, err := client.GetWafPolicy(name)
+		if err != nil {
+			return err
+		}
+		if policy != nil {
+			return fmt.Errorf("WAF policy %s not destroyed.", name)
+		}
+	}
+	return nil
+}
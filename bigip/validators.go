@@ -2,6 +2,7 @@ package bigip
 
 import (
 	"fmt"
+	"net"
 	"reflect"
 	"regexp"
 
@@ -132,3 +133,26 @@ func validateDataGroupType(value interface{}, field string) (ws []string, errors
 	}
 	return
 }
+
+func validatePartitionName(value interface{}, field string) (ws []string, errors []error) {
+	match, _ := regexp.MatchString("^[\\w_\\-.]+$", value.(string))
+	if !match {
+		errors = append(errors, fmt.Errorf("%q must be a partition name without slashes and contain letters, numbers or [._-]. e.g. Tenant_A", field))
+	}
+	return
+}
+
+func validateSoftwareVolume(value interface{}, field string) (ws []string, errors []error) {
+	match, _ := regexp.MatchString("^(HD|MD)\\d+\\.\\d+$", value.(string))
+	if !match {
+		errors = append(errors, fmt.Errorf("%q must be a boot volume name, e.g. HD1.2", field))
+	}
+	return
+}
+
+func validateManagementIp(value interface{}, field string) (ws []string, errors []error) {
+	if _, _, err := net.ParseCIDR(value.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be an address with the netmask, e.g. 192.168.1.245/24", field))
+	}
+	return
+}
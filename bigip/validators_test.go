@@ -96,3 +96,33 @@ func TestValidateReqPrefDisabledString(t *testing.T) {
 		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
 	}
 }
+
+func TestValidateSoftwareVolume(t *testing.T) {
+	data := map[string]int{
+		"HD1.1":  0,
+		"HD1.12": 0,
+		"MD1.2":  0,
+		"HD1":    1,
+		"hd1.2":  1,
+		"potato": 1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateSoftwareVolume(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}
+
+func TestValidateManagementIp(t *testing.T) {
+	data := map[string]int{
+		"192.168.1.245/24": 0,
+		"2001:db8::5/64":   0,
+		"192.168.1.245":    1,
+		"potato/24":        1,
+	}
+
+	for d, ec := range data {
+		_, errs := validateManagementIp(d, "testField")
+		assert.Equal(t, ec, len(errs), "%s did not throw %d errors", d, ec)
+	}
+}